@@ -0,0 +1,268 @@
+package json
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// The Go type of JSON numbers returned by ToAny().
+type AnyNumberFormat int
+
+const (
+	// encoding/json.Number with the original lexeme.
+	AnyNumberFormatJSONNumber AnyNumberFormat = iota
+	// float64, which may lose precision.
+	AnyNumberFormatFloat64
+	// *big.Rat, which represents every JSON number exactly.
+	AnyNumberFormatRat
+)
+
+// Converts the value to the representation used by encoding/json:
+// nil, bool, string, map[string]any, []any, or a number type depending on the number format.
+// Returns an error if a JSON number cannot be represented with the number format.
+func (value *ValueStruct) ToAny(numberFormat AnyNumberFormat) (any, error) {
+	switch value.kind {
+	case KindString:
+		return value.s, nil
+	case KindNumber:
+		return numberToAny(value.s, numberFormat)
+	case KindBool:
+		return value.b, nil
+	case KindObject:
+		return value.object.ToAny(numberFormat)
+	case KindArray:
+		return value.array.ToAny(numberFormat)
+	}
+	return nil, nil
+}
+
+// Converts the object to a map[string]any as used by encoding/json.
+// See ValueStruct.ToAny().
+func (object *ObjectStruct) ToAny(numberFormat AnyNumberFormat) (map[string]any, error) {
+	result := make(map[string]any, len(object.Keys))
+	for _, key := range object.Keys {
+		value, _ := object.get(key)
+		converted, err := value.ToAny(numberFormat)
+		if err != nil {
+			return nil, fmt.Errorf("member %s: %w", key, err)
+		}
+		result[key] = converted
+	}
+	return result, nil
+}
+
+// Converts the array to a []any as used by encoding/json.
+// See ValueStruct.ToAny().
+func (array *ArrayStruct) ToAny(numberFormat AnyNumberFormat) ([]any, error) {
+	result := make([]any, array.Length)
+	for i := range array.Length {
+		value, _ := array.get(i)
+		converted, err := value.ToAny(numberFormat)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		result[i] = converted
+	}
+	return result, nil
+}
+
+func numberToAny(lexeme string, numberFormat AnyNumberFormat) (any, error) {
+	switch numberFormat {
+	case AnyNumberFormatFloat64:
+		parsed, err := parseFloat(lexeme, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse float64: %w", err)
+		}
+		return parsed, nil
+	case AnyNumberFormatRat:
+		parsed, err := parseRat(lexeme)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse big.Rat: %w", err)
+		}
+		return parsed, nil
+	}
+	return stdjson.Number(lexeme), nil
+}
+
+// Converts a Go value to a JSON value.
+// Supported values are:
+//   - nil and nil pointers, maps, and slices, which are converted to null
+//   - booleans, strings, integers, and floats, including named types
+//   - encoding/json.Number, *big.Int, *big.Float, and *big.Rat
+//   - []byte, which is converted to a standard base64 string like encoding/json
+//   - maps with string keys, whose members are sorted by key
+//   - slices and arrays
+//   - ValueStruct, ObjectStruct, and ArrayStruct
+//   - structs, whose exported fields are converted as described in [Marshal]
+//   - encoding/json.Marshaler and encoding.TextMarshaler implementations
+//
+// Pointers and interfaces are dereferenced.
+// Returns an error if a value isn't supported, is a NaN or infinite number,
+// or contains itself (e.g. a map that is its own member).
+// The error includes the JSON Pointer of the value.
+func FromAny(value any) (ValueStruct, error) {
+	return fromAny(reflect.ValueOf(value), "", map[fromAnyVisitStruct]struct{}{})
+}
+
+// A map, slice, or pointer on the current path.
+// The type is included since a pointer to a struct and its first field share an address,
+// and the length since slices of a shared array may start at the same address.
+type fromAnyVisitStruct struct {
+	pointer uintptr
+	t       reflect.Type
+	length  int
+}
+
+var (
+	valueStructType = reflect.TypeFor[ValueStruct]()
+	objectType      = reflect.TypeFor[ObjectStruct]()
+	arrayType       = reflect.TypeFor[ArrayStruct]()
+	jsonNumberType  = reflect.TypeFor[stdjson.Number]()
+	bigIntType      = reflect.TypeFor[big.Int]()
+	bigFloatType    = reflect.TypeFor[big.Float]()
+	ratType         = reflect.TypeFor[big.Rat]()
+)
+
+func fromAny(value reflect.Value, path string, visited map[fromAnyVisitStruct]struct{}) (ValueStruct, error) {
+	if !value.IsValid() {
+		return NewNullValue(), nil
+	}
+	switch value.Type() {
+	case valueStructType:
+		converted := value.Interface().(ValueStruct)
+		return converted.Clone(), nil
+	case objectType:
+		converted := value.Interface().(ObjectStruct)
+		return NewJSONObjectValue(converted.Clone()), nil
+	case arrayType:
+		converted := value.Interface().(ArrayStruct)
+		return NewJSONArrayValue(converted.Clone()), nil
+	case jsonNumberType:
+		if !isNumberLexeme(value.String()) {
+			return ValueStruct{}, fmt.Errorf("invalid number %s at %s", strconv.Quote(value.String()), strconv.Quote(path))
+		}
+		return NewNumberValue(value.String()), nil
+	case bigIntType, bigFloatType, ratType:
+		pointer := reflect.New(value.Type())
+		pointer.Elem().Set(value)
+		return fromAny(pointer, path, visited)
+	}
+
+	// Checked before marshalers since these types implement them.
+	if value.Kind() == reflect.Pointer && !value.IsNil() {
+		switch value.Type().Elem() {
+		case valueStructType, objectType, arrayType, jsonNumberType:
+			return fromAny(value.Elem(), path, visited)
+		case bigIntType:
+			return NewNumberValue(value.Interface().(*big.Int).String()), nil
+		case bigFloatType:
+			encoded, err := formatBigFloat(value.Interface().(*big.Float))
+			if err != nil {
+				return ValueStruct{}, fmt.Errorf("failed to format big.Float at %s: %w", strconv.Quote(path), err)
+			}
+			return NewNumberValue(encoded), nil
+		case ratType:
+			encoded, err := formatRat(value.Interface().(*big.Rat))
+			if err != nil {
+				return ValueStruct{}, fmt.Errorf("failed to format big.Rat at %s: %w", strconv.Quote(path), err)
+			}
+			return NewNumberValue(encoded), nil
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if !value.IsNil() {
+			visit := fromAnyVisitStruct{pointer: value.Pointer(), t: value.Type()}
+			if value.Kind() == reflect.Slice {
+				visit.length = value.Len()
+			}
+			if _, ok := visited[visit]; ok {
+				return ValueStruct{}, fmt.Errorf("unsupported value: cycle at %s", strconv.Quote(path))
+			}
+			visited[visit] = struct{}{}
+			defer delete(visited, visit)
+		}
+	}
+
+	if converted, ok, err := fromMarshaler(value, path); ok {
+		return converted, err
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return NewNullValue(), nil
+		}
+		return fromAny(value.Elem(), path, visited)
+	case reflect.Interface:
+		if value.IsNil() {
+			return NewNullValue(), nil
+		}
+		return fromAny(value.Elem(), path, visited)
+	case reflect.Bool:
+		return NewBoolValue(value.Bool()), nil
+	case reflect.String:
+		return NewStringValue(value.String()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return NewNumberValue(strconv.FormatInt(value.Int(), 10)), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return NewNumberValue(strconv.FormatUint(value.Uint(), 10)), nil
+	case reflect.Float32, reflect.Float64:
+		encoded, err := formatFloat(value.Float(), value.Type().Bits())
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to format float at %s: %w", strconv.Quote(path), err)
+		}
+		return NewNumberValue(encoded), nil
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return ValueStruct{}, fmt.Errorf("unsupported map key type %s at %s", value.Type().Key(), strconv.Quote(path))
+		}
+		if value.IsNil() {
+			return NewNullValue(), nil
+		}
+		keys := value.MapKeys()
+		slices.SortFunc(keys, func(a reflect.Value, b reflect.Value) int {
+			return strings.Compare(a.String(), b.String())
+		})
+		object := NewObject()
+		for _, key := range keys {
+			converted, err := fromAny(value.MapIndex(key), path+"/"+escapeJSONPointerToken(key.String()), visited)
+			if err != nil {
+				return ValueStruct{}, err
+			}
+			object.Set(key.String(), converted)
+		}
+		return NewJSONObjectValue(object), nil
+	case reflect.Slice:
+		if value.IsNil() {
+			return NewNullValue(), nil
+		}
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return NewStringValue(encodeBytes(value.Bytes(), BytesEncodingBase64)), nil
+		}
+		return fromAnyElements(value, path, visited)
+	case reflect.Array:
+		return fromAnyElements(value, path, visited)
+	case reflect.Struct:
+		return fromStruct(value, path, visited)
+	}
+	return ValueStruct{}, fmt.Errorf("unsupported type %s at %s", value.Type(), strconv.Quote(path))
+}
+
+func fromAnyElements(value reflect.Value, path string, visited map[fromAnyVisitStruct]struct{}) (ValueStruct, error) {
+	array := NewArray()
+	for i := range value.Len() {
+		converted, err := fromAny(value.Index(i), path+"/"+strconv.Itoa(i), visited)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		array.Add(converted)
+	}
+	return NewJSONArrayValue(array), nil
+}
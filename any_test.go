@@ -0,0 +1,129 @@
+package json
+
+import (
+	stdjson "encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestToAny(t *testing.T) {
+	object, err := ParseObject(`{"a":[1.50,"b",true,null],"c":{"d":1e400}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	converted, err := object.ToAny(AnyNumberFormatJSONNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]any{
+		"a": []any{stdjson.Number("1.50"), "b", true, nil},
+		"c": map[string]any{"d": stdjson.Number("1e400")},
+	}
+	if !reflect.DeepEqual(converted, expected) {
+		t.Errorf("unexpected output: %#v", converted)
+	}
+
+	converted, err = object.ToAny(AnyNumberFormatRat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := converted["a"].([]any)[0].(*big.Rat); r.Cmp(big.NewRat(3, 2)) != 0 {
+		t.Errorf("unexpected number: %s", r)
+	}
+
+	_, err = object.ToAny(AnyNumberFormatFloat64)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestFromAny(t *testing.T) {
+	type name string
+	successCases := []fromAnyTestCaseStruct{
+		{nil, `null`},
+		{map[string]any{"b": 1, "a": []string{"x"}, "c": nil}, `{"a":["x"],"b":1,"c":null}`},
+		{[2]float64{0.5, 1e21}, `[0.5,1e+21]`},
+		{map[name]name{"k": "v"}, `{"k":"v"}`},
+		{[]any{stdjson.Number("1.50"), big.NewInt(-2), big.NewRat(1, 4), uint8(3)}, `[1.50,-2,0.25,3]`},
+		{[]byte("hi"), `"aGk="`},
+		{[]int(nil), `null`},
+	}
+	for _, c := range successCases {
+		value, err := FromAny(c.value)
+		if err != nil {
+			t.Errorf("error on input %#v: %s", c.value, err)
+			continue
+		}
+		if got := value.String(MinimalStringCharacterEscapingBehavior); got != c.expected {
+			t.Errorf("unexpected output on input %#v: %s", c.value, got)
+		}
+	}
+
+	failCases := []any{
+		map[int]string{1: "a"},
+		map[string]any{"a": []any{func() {}}},
+		stdjson.Number("01"),
+		big.NewRat(1, 3),
+		math.NaN(),
+		math.Inf(1),
+	}
+	for _, value := range failCases {
+		_, err := FromAny(value)
+		if err == nil {
+			t.Errorf("expected error on input %#v", value)
+		}
+	}
+}
+
+func TestFromAnyCycle(t *testing.T) {
+	type node struct {
+		Next *node
+	}
+	cyclicNode := &node{}
+	cyclicNode.Next = cyclicNode
+	cyclicMap := map[string]any{}
+	cyclicMap["a"] = []any{cyclicMap}
+	cyclicSlice := []any{nil}
+	cyclicSlice[0] = cyclicSlice
+	cyclicPointer := new(any)
+	*cyclicPointer = cyclicPointer
+
+	failCases := []fromAnyCycleTestCaseStruct{
+		{cyclicNode, "/Next"},
+		{cyclicMap, "/a/0"},
+		{cyclicSlice, "/0"},
+		{cyclicPointer, ""},
+	}
+	for _, c := range failCases {
+		_, err := FromAny(c.value)
+		if err == nil {
+			t.Errorf("expected error on cycle at %s", c.path)
+			continue
+		}
+		if expected := "unsupported value: cycle at " + strconv.Quote(c.path); err.Error() != expected {
+			t.Errorf("unexpected error on cycle at %s: %s", c.path, err)
+		}
+	}
+
+	shared := []int{1}
+	value, err := FromAny(map[string]any{"a": shared, "b": shared})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := value.String(MinimalStringCharacterEscapingBehavior); got != `{"a":[1],"b":[1]}` {
+		t.Errorf("unexpected output: %s", got)
+	}
+}
+
+type fromAnyTestCaseStruct struct {
+	value    any
+	expected string
+}
+
+type fromAnyCycleTestCaseStruct struct {
+	value any
+	path  string
+}
@@ -2,6 +2,7 @@ package json
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 )
 
@@ -11,9 +12,13 @@ type ArrayStruct struct {
 	numbers map[int]string
 	bools   map[int]bool
 	nulls   map[int]struct{}
-	objects map[int]ObjectStruct
-	arrays  map[int]ArrayStruct
+	objects map[int]*ObjectStruct
+	arrays  map[int]*ArrayStruct
 	Length  int // Read-only.
+
+	frozen bool
+	// If true, the maps are shared with a frozen array and are copied before the first write.
+	copyOnWrite bool
 }
 
 func NewArray() ArrayStruct {
@@ -22,14 +27,15 @@ func NewArray() ArrayStruct {
 		numbers: map[int]string{},
 		bools:   map[int]bool{},
 		nulls:   map[int]struct{}{},
-		objects: map[int]ObjectStruct{},
-		arrays:  map[int]ArrayStruct{},
+		objects: map[int]*ObjectStruct{},
+		arrays:  map[int]*ArrayStruct{},
 		Length:  0,
 	}
 	return array
 }
 
 func (array *ArrayStruct) removeElement(index int) {
+	array.prepareWrite()
 	delete(array.strings, index)
 	delete(array.numbers, index)
 	delete(array.bools, index)
@@ -38,6 +44,97 @@ func (array *ArrayStruct) removeElement(index int) {
 	delete(array.arrays, index)
 }
 
+// Returns an error if an item doesn't exist in the index.
+func (array *ArrayStruct) Get(index int) (ValueStruct, error) {
+	value, ok := array.get(index)
+	if !ok {
+		return ValueStruct{}, fmt.Errorf("element %d %w", index, ErrNotFound)
+	}
+	return value, nil
+}
+
+// Sets a JSON value at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) Set(index int, value ValueStruct) {
+	if index >= array.Length {
+		panic("out of bounds")
+	}
+	array.removeElement(index)
+	array.setElement(index, value)
+}
+
+// Appends a JSON value at the end of the array.
+func (array *ArrayStruct) Add(value ValueStruct) {
+	array.setElement(array.Length, value)
+	array.Length++
+}
+
+// Inserts a JSON value at index, shifting the element at index and any subsequent elements to the right.
+// Panics if the index is out of bounds.
+// The index may be equal to the array length.
+func (array *ArrayStruct) Insert(index int, value ValueStruct) {
+	if index < 0 || index > array.Length {
+		panic("out of bounds")
+	}
+	for i := array.Length; i > index; i-- {
+		array.moveElement(i-1, i)
+	}
+	array.setElement(index, value)
+	array.Length++
+}
+
+// Removes the element at index, shifting any subsequent elements to the left.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) Remove(index int) {
+	if index < 0 || index >= array.Length {
+		panic("out of bounds")
+	}
+	array.removeElement(index)
+	for i := index + 1; i < array.Length; i++ {
+		array.moveElement(i, i-1)
+	}
+	array.Length--
+}
+
+// Moves the element at from to the empty index to.
+// Pointers to nested objects and arrays remain valid.
+func (array *ArrayStruct) moveElement(from int, to int) {
+	array.prepareWrite()
+	moveMapEntry(array.strings, from, to)
+	moveMapEntry(array.numbers, from, to)
+	moveMapEntry(array.bools, from, to)
+	moveMapEntry(array.nulls, from, to)
+	moveMapEntry(array.objects, from, to)
+	moveMapEntry(array.arrays, from, to)
+}
+
+func moveMapEntry[T any](values map[int]T, from int, to int) {
+	if value, ok := values[from]; ok {
+		delete(values, from)
+		values[to] = value
+	}
+}
+
+func (array *ArrayStruct) setElement(index int, value ValueStruct) {
+	array.prepareWrite()
+	switch value.kind {
+	case KindString:
+		array.strings[index] = value.s
+	case KindNumber:
+		array.numbers[index] = value.s
+	case KindBool:
+		array.bools[index] = value.b
+	case KindObject:
+		object := value.object
+		array.objects[index] = &object
+	case KindArray:
+		nested := value.array
+		array.arrays[index] = &nested
+	default:
+		array.nulls[index] = struct{}{}
+	}
+}
+
 // Sets a JSON string value at index.
 // Panics if the index is out of bounds.
 func (array *ArrayStruct) SetString(index int, value string) {
@@ -50,6 +147,7 @@ func (array *ArrayStruct) SetString(index int, value string) {
 
 // Appends a JSON string value at the end of the array.
 func (array *ArrayStruct) AddString(value string) {
+	array.prepareWrite()
 	array.strings[array.Length] = value
 	array.Length++
 }
@@ -58,7 +156,7 @@ func (array *ArrayStruct) AddString(value string) {
 func (array *ArrayStruct) GetString(index int) (string, error) {
 	value, ok := array.strings[index]
 	if !ok {
-		return "", fmt.Errorf("no matching member")
+		return "", array.elementError(index, KindString)
 	}
 	return value, nil
 }
@@ -75,6 +173,7 @@ func (array *ArrayStruct) SetNumber(index int, value string) {
 
 // Appends a JSON number value at the end of the array.
 func (array *ArrayStruct) AddNumber(value string) {
+	array.prepareWrite()
 	array.numbers[array.Length] = value
 	array.Length++
 }
@@ -83,7 +182,7 @@ func (array *ArrayStruct) AddNumber(value string) {
 func (array *ArrayStruct) GetNumber(key int) (string, error) {
 	value, ok := array.numbers[key]
 	if !ok {
-		return "", fmt.Errorf("no matching member")
+		return "", array.elementError(key, KindNumber)
 	}
 	return value, nil
 }
@@ -105,13 +204,13 @@ func (array *ArrayStruct) AddInt(value int) {
 func (array *ArrayStruct) GetInt(key int) (int, error) {
 	value, err := array.GetNumber(key)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get number: %s", err.Error())
+		return 0, fmt.Errorf("failed to get number: %w", err)
 	}
-	parsed, err := strconv.Atoi(value)
+	parsed, err := parseSignedInteger(value, strconv.IntSize)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse int: %s", err.Error())
+		return 0, fmt.Errorf("failed to parse int: %w", err)
 	}
-	return parsed, nil
+	return int(parsed), nil
 }
 
 // Sets a JSON number value at index.
@@ -131,11 +230,11 @@ func (array *ArrayStruct) AddInt64(value int64) {
 func (array *ArrayStruct) GetInt64(key int) (int64, error) {
 	value, err := array.GetNumber(key)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get number: %s", err.Error())
+		return 0, fmt.Errorf("failed to get number: %w", err)
 	}
-	parsed, err := strconv.ParseInt(value, 10, 64)
+	parsed, err := parseSignedInteger(value, 64)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse int64: %s", err.Error())
+		return 0, fmt.Errorf("failed to parse int64: %w", err)
 	}
 	return parsed, nil
 }
@@ -157,15 +256,324 @@ func (array *ArrayStruct) AddInt32(value int32) {
 func (array *ArrayStruct) GetInt32(key int) (int32, error) {
 	value, err := array.GetNumber(key)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get number: %s", err.Error())
+		return 0, fmt.Errorf("failed to get number: %w", err)
 	}
-	parsed, err := strconv.ParseInt(value, 10, 32)
+	parsed, err := parseSignedInteger(value, 32)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse int32: %s", err.Error())
+		return 0, fmt.Errorf("failed to parse int32: %w", err)
 	}
 	return int32(parsed), nil
 }
 
+// Sets a JSON number value at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetUint(index int, value uint) {
+	array.SetNumber(index, strconv.FormatUint(uint64(value), 10))
+}
+
+// Appends a JSON number value at the end of the array.
+func (array *ArrayStruct) AddUint(value uint) {
+	array.AddNumber(strconv.FormatUint(uint64(value), 10))
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (array *ArrayStruct) GetUint(key int) (uint, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, strconv.IntSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint: %w", err)
+	}
+	return uint(parsed), nil
+}
+
+// Sets a JSON number value at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetUint8(index int, value uint8) {
+	array.SetNumber(index, strconv.FormatUint(uint64(value), 10))
+}
+
+// Appends a JSON number value at the end of the array.
+func (array *ArrayStruct) AddUint8(value uint8) {
+	array.AddNumber(strconv.FormatUint(uint64(value), 10))
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint8.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (array *ArrayStruct) GetUint8(key int) (uint8, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, 8)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint8: %w", err)
+	}
+	return uint8(parsed), nil
+}
+
+// Sets a JSON number value at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetUint16(index int, value uint16) {
+	array.SetNumber(index, strconv.FormatUint(uint64(value), 10))
+}
+
+// Appends a JSON number value at the end of the array.
+func (array *ArrayStruct) AddUint16(value uint16) {
+	array.AddNumber(strconv.FormatUint(uint64(value), 10))
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint16.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (array *ArrayStruct) GetUint16(key int) (uint16, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, 16)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint16: %w", err)
+	}
+	return uint16(parsed), nil
+}
+
+// Sets a JSON number value at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetUint32(index int, value uint32) {
+	array.SetNumber(index, strconv.FormatUint(uint64(value), 10))
+}
+
+// Appends a JSON number value at the end of the array.
+func (array *ArrayStruct) AddUint32(value uint32) {
+	array.AddNumber(strconv.FormatUint(uint64(value), 10))
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint32.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (array *ArrayStruct) GetUint32(key int) (uint32, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, 32)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint32: %w", err)
+	}
+	return uint32(parsed), nil
+}
+
+// Sets a JSON number value at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetUint64(index int, value uint64) {
+	array.SetNumber(index, strconv.FormatUint(value, 10))
+}
+
+// Appends a JSON number value at the end of the array.
+func (array *ArrayStruct) AddUint64(value uint64) {
+	array.AddNumber(strconv.FormatUint(value, 10))
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint64.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (array *ArrayStruct) GetUint64(key int) (uint64, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint64: %w", err)
+	}
+	return parsed, nil
+}
+
+// Sets a JSON number value at index.
+// Returns an error if the value is NaN or infinite.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetFloat64(index int, value float64) error {
+	encoded, err := formatFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("failed to format float64: %w", err)
+	}
+	array.SetNumber(index, encoded)
+	return nil
+}
+
+// Appends a JSON number value at the end of the array.
+// Returns an error if the value is NaN or infinite.
+func (array *ArrayStruct) AddFloat64(value float64) error {
+	encoded, err := formatFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("failed to format float64: %w", err)
+	}
+	array.AddNumber(encoded)
+	return nil
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON number,
+// or the JSON number is outside the range of a float64.
+func (array *ArrayStruct) GetFloat64(key int) (float64, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float64: %w", err)
+	}
+	return parsed, nil
+}
+
+// Sets a JSON number value at index.
+// Returns an error if the value is NaN or infinite.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetFloat32(index int, value float32) error {
+	encoded, err := formatFloat(float64(value), 32)
+	if err != nil {
+		return fmt.Errorf("failed to format float32: %w", err)
+	}
+	array.SetNumber(index, encoded)
+	return nil
+}
+
+// Appends a JSON number value at the end of the array.
+// Returns an error if the value is NaN or infinite.
+func (array *ArrayStruct) AddFloat32(value float32) error {
+	encoded, err := formatFloat(float64(value), 32)
+	if err != nil {
+		return fmt.Errorf("failed to format float32: %w", err)
+	}
+	array.AddNumber(encoded)
+	return nil
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON number,
+// or the JSON number is outside the range of a float32.
+func (array *ArrayStruct) GetFloat32(key int) (float32, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseFloat(value, 32)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float32: %w", err)
+	}
+	return float32(parsed), nil
+}
+
+// Sets a JSON number value at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetBigInt(index int, value *big.Int) {
+	array.SetNumber(index, value.String())
+}
+
+// Appends a JSON number value at the end of the array.
+func (array *ArrayStruct) AddBigInt(value *big.Int) {
+	array.AddNumber(value.String())
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON number,
+// or the JSON number isn't an integer.
+func (array *ArrayStruct) GetBigInt(key int) (*big.Int, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseBigInt(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse big.Int: %w", err)
+	}
+	return parsed, nil
+}
+
+// Sets a JSON number value at index.
+// Returns an error if the value is infinite.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetBigFloat(index int, value *big.Float) error {
+	encoded, err := formatBigFloat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Float: %w", err)
+	}
+	array.SetNumber(index, encoded)
+	return nil
+}
+
+// Appends a JSON number value at the end of the array.
+// Returns an error if the value is infinite.
+func (array *ArrayStruct) AddBigFloat(value *big.Float) error {
+	encoded, err := formatBigFloat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Float: %w", err)
+	}
+	array.AddNumber(encoded)
+	return nil
+}
+
+// Returns an error if an item doesn't exist in the index or the value isn't a JSON number.
+func (array *ArrayStruct) GetBigFloat(key int) (*big.Float, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseBigFloat(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse big.Float: %w", err)
+	}
+	return parsed, nil
+}
+
+// Sets a JSON number value at index.
+// Returns an error if the value has no finite decimal representation.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetRat(index int, value *big.Rat) error {
+	encoded, err := formatRat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Rat: %w", err)
+	}
+	array.SetNumber(index, encoded)
+	return nil
+}
+
+// Appends a JSON number value at the end of the array.
+// Returns an error if the value has no finite decimal representation.
+func (array *ArrayStruct) AddRat(value *big.Rat) error {
+	encoded, err := formatRat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Rat: %w", err)
+	}
+	array.AddNumber(encoded)
+	return nil
+}
+
+// Returns an error if an item doesn't exist in the index or the value isn't a JSON number.
+func (array *ArrayStruct) GetRat(key int) (*big.Rat, error) {
+	value, err := array.GetNumber(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseRat(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse big.Rat: %w", err)
+	}
+	return parsed, nil
+}
+
 // Sets a JSON boolean value at index.
 // Panics if the index is out of bounds.
 func (array *ArrayStruct) SetBool(index int, value bool) {
@@ -178,6 +586,7 @@ func (array *ArrayStruct) SetBool(index int, value bool) {
 
 // Appends a JSON boolean value at the end of the array.
 func (array *ArrayStruct) AddBool(value bool) {
+	array.prepareWrite()
 	array.bools[array.Length] = value
 	array.Length++
 }
@@ -186,7 +595,7 @@ func (array *ArrayStruct) AddBool(value bool) {
 func (array *ArrayStruct) GetBool(index int) (bool, error) {
 	value, ok := array.bools[index]
 	if !ok {
-		return false, fmt.Errorf("no matching member")
+		return false, array.elementError(index, KindBool)
 	}
 	return value, nil
 }
@@ -198,20 +607,45 @@ func (array *ArrayStruct) SetJSONObject(index int, value ObjectStruct) {
 		panic("out of bounds")
 	}
 	array.removeElement(index)
-	array.objects[index] = value
+	array.objects[index] = &value
 }
 
 // Appends a JSON object value at the end of the array.
 func (array *ArrayStruct) AddJSONObject(value ObjectStruct) {
-	array.objects[array.Length] = value
+	array.prepareWrite()
+	array.objects[array.Length] = &value
 	array.Length++
 }
 
 // Returns an error if an item doesn't exist in the index or the value isn't a JSON object.
+// Use ArrayStruct.Object() to modify the element in place.
 func (array *ArrayStruct) GetJSONObject(index int) (ObjectStruct, error) {
 	value, ok := array.objects[index]
 	if !ok {
-		return value, fmt.Errorf("no matching member")
+		return ObjectStruct{}, array.elementError(index, KindObject)
+	}
+	return array.readObject(value), nil
+}
+
+// Returns a pointer to a JSON object element.
+// Changes made through the pointer are reflected in the array.
+// The pointer is no longer valid once the element is replaced or removed.
+// If the array is frozen, the returned object is a frozen copy.
+// Returns an error if an item doesn't exist in the index or the value isn't a JSON object.
+func (array *ArrayStruct) Object(index int) (*ObjectStruct, error) {
+	value, ok := array.objects[index]
+	if !ok {
+		return nil, array.elementError(index, KindObject)
+	}
+	if array.frozen {
+		copied := *value
+		return &copied, nil
+	}
+	if value.frozen {
+		thawed := value.Thaw()
+		array.prepareWrite()
+		array.objects[index] = &thawed
+		return &thawed, nil
 	}
 	return value, nil
 }
@@ -223,20 +657,45 @@ func (array *ArrayStruct) SetJSONArray(index int, value ArrayStruct) {
 		panic("out of bounds")
 	}
 	array.removeElement(index)
-	array.arrays[index] = value
+	array.arrays[index] = &value
 }
 
 // Appends a JSON array value at the end of the array.
 func (array *ArrayStruct) AddJSONArray(value ArrayStruct) {
-	array.arrays[array.Length] = value
+	array.prepareWrite()
+	array.arrays[array.Length] = &value
 	array.Length++
 }
 
 // Returns an error if an item doesn't exist in the index or the value isn't a JSON array.
+// Use ArrayStruct.Array() to modify the element in place.
 func (array *ArrayStruct) GetJSONArray(index int) (ArrayStruct, error) {
 	value, ok := array.arrays[index]
 	if !ok {
-		return value, fmt.Errorf("no matching member")
+		return ArrayStruct{}, array.elementError(index, KindArray)
+	}
+	return array.readArray(value), nil
+}
+
+// Returns a pointer to a JSON array element.
+// Changes made through the pointer are reflected in the array.
+// The pointer is no longer valid once the element is replaced or removed.
+// If the array is frozen, the returned array is a frozen copy.
+// Returns an error if an item doesn't exist in the index or the value isn't a JSON array.
+func (array *ArrayStruct) Array(index int) (*ArrayStruct, error) {
+	value, ok := array.arrays[index]
+	if !ok {
+		return nil, array.elementError(index, KindArray)
+	}
+	if array.frozen {
+		copied := *value
+		return &copied, nil
+	}
+	if value.frozen {
+		thawed := value.Thaw()
+		array.prepareWrite()
+		array.arrays[index] = &thawed
+		return &thawed, nil
 	}
 	return value, nil
 }
@@ -253,15 +712,16 @@ func (array *ArrayStruct) SetNull(index int) {
 
 // Appends a JSON null value at the end of the array.
 func (array *ArrayStruct) AddNull() {
+	array.prepareWrite()
 	array.nulls[array.Length] = struct{}{}
 	array.Length++
 }
 
-// Returns an error if an item doesn't exist in the index.
+// Returns an error if an item doesn't exist in the index or the item isn't null.
 func (array *ArrayStruct) IsNull(index int) (bool, error) {
 	_, ok := array.nulls[index]
 	if !ok {
-		return false, fmt.Errorf("no matching member")
+		return false, array.elementError(index, KindNull)
 	}
 	return true, nil
 }
@@ -272,6 +732,16 @@ func (array *ArrayStruct) ExistsAndIsNull(index int) bool {
 	return ok
 }
 
+// Returns a deep copy of the array.
+func (array *ArrayStruct) Clone() ArrayStruct {
+	cloned := NewArray()
+	for i := range array.Length {
+		value, _ := array.get(i)
+		cloned.Add(value.Clone())
+	}
+	return cloned
+}
+
 // Encodes the array using ArrayBuilderStruct.
 // Embedded objects are encoded with ObjectStruct.String().
 // Embedded arrays are encoded with ArrayStruct.String().
@@ -1,6 +1,8 @@
 package json
 
 import (
+	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 )
@@ -71,6 +73,115 @@ func (objectBuilder *ObjectBuilderStruct) AddInt32(name string, value int32) {
 	objectBuilder.AddInt64(name, int64(value))
 }
 
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddUint(name string, value uint) {
+	encoded := strconv.FormatUint(uint64(value), 10)
+	objectBuilder.AddJSON(name, encoded)
+}
+
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddUint8(name string, value uint8) {
+	encoded := strconv.FormatUint(uint64(value), 10)
+	objectBuilder.AddJSON(name, encoded)
+}
+
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddUint16(name string, value uint16) {
+	encoded := strconv.FormatUint(uint64(value), 10)
+	objectBuilder.AddJSON(name, encoded)
+}
+
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddUint32(name string, value uint32) {
+	encoded := strconv.FormatUint(uint64(value), 10)
+	objectBuilder.AddJSON(name, encoded)
+}
+
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddUint64(name string, value uint64) {
+	encoded := strconv.FormatUint(value, 10)
+	objectBuilder.AddJSON(name, encoded)
+}
+
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+// Returns an error if the value is NaN or infinite.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddFloat64(name string, value float64) error {
+	encoded, err := formatFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("failed to format float64: %s", err.Error())
+	}
+	objectBuilder.AddJSON(name, encoded)
+	return nil
+}
+
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+// Returns an error if the value is NaN or infinite.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddFloat32(name string, value float32) error {
+	encoded, err := formatFloat(float64(value), 32)
+	if err != nil {
+		return fmt.Errorf("failed to format float32: %s", err.Error())
+	}
+	objectBuilder.AddJSON(name, encoded)
+	return nil
+}
+
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddBigInt(name string, value *big.Int) {
+	objectBuilder.AddJSON(name, value.String())
+}
+
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+// Returns an error if the value is infinite.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddBigFloat(name string, value *big.Float) error {
+	encoded, err := formatBigFloat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Float: %s", err.Error())
+	}
+	objectBuilder.AddJSON(name, encoded)
+	return nil
+}
+
+// Encodes the name to a JSON string and value to a JSON number, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+// Returns an error if the value has no finite decimal representation.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddRat(name string, value *big.Rat) error {
+	encoded, err := formatRat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Rat: %s", err.Error())
+	}
+	objectBuilder.AddJSON(name, encoded)
+	return nil
+}
+
 // Encodes the name to a JSON string and value to a JSON boolean, and adds a new object member.
 // Succeeds even if a member with the same name already exists.
 //
@@ -151,6 +262,85 @@ func (arrayBuilder *ArrayBuilderStruct) AddInt32(key string, value int32) {
 	arrayBuilder.AddJSON(encoded)
 }
 
+// Encodes the value to a JSON number and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddUint(value uint) {
+	encoded := strconv.FormatUint(uint64(value), 10)
+	arrayBuilder.AddJSON(encoded)
+}
+
+// Encodes the value to a JSON number and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddUint8(value uint8) {
+	encoded := strconv.FormatUint(uint64(value), 10)
+	arrayBuilder.AddJSON(encoded)
+}
+
+// Encodes the value to a JSON number and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddUint16(value uint16) {
+	encoded := strconv.FormatUint(uint64(value), 10)
+	arrayBuilder.AddJSON(encoded)
+}
+
+// Encodes the value to a JSON number and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddUint32(value uint32) {
+	encoded := strconv.FormatUint(uint64(value), 10)
+	arrayBuilder.AddJSON(encoded)
+}
+
+// Encodes the value to a JSON number and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddUint64(value uint64) {
+	encoded := strconv.FormatUint(value, 10)
+	arrayBuilder.AddJSON(encoded)
+}
+
+// Encodes the value to a JSON number and adds it as a new array element.
+// Returns an error if the value is NaN or infinite.
+func (arrayBuilder *ArrayBuilderStruct) AddFloat64(value float64) error {
+	encoded, err := formatFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("failed to format float64: %s", err.Error())
+	}
+	arrayBuilder.AddJSON(encoded)
+	return nil
+}
+
+// Encodes the value to a JSON number and adds it as a new array element.
+// Returns an error if the value is NaN or infinite.
+func (arrayBuilder *ArrayBuilderStruct) AddFloat32(value float32) error {
+	encoded, err := formatFloat(float64(value), 32)
+	if err != nil {
+		return fmt.Errorf("failed to format float32: %s", err.Error())
+	}
+	arrayBuilder.AddJSON(encoded)
+	return nil
+}
+
+// Encodes the value to a JSON number and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddBigInt(value *big.Int) {
+	arrayBuilder.AddJSON(value.String())
+}
+
+// Encodes the value to a JSON number and adds it as a new array element.
+// Returns an error if the value is infinite.
+func (arrayBuilder *ArrayBuilderStruct) AddBigFloat(value *big.Float) error {
+	encoded, err := formatBigFloat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Float: %s", err.Error())
+	}
+	arrayBuilder.AddJSON(encoded)
+	return nil
+}
+
+// Encodes the value to a JSON number and adds it as a new array element.
+// Returns an error if the value has no finite decimal representation.
+func (arrayBuilder *ArrayBuilderStruct) AddRat(value *big.Rat) error {
+	encoded, err := formatRat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Rat: %s", err.Error())
+	}
+	arrayBuilder.AddJSON(encoded)
+	return nil
+}
+
 // Encodes the value to a JSON boolean and adds it as a new array element.
 func (arrayBuilder *ArrayBuilderStruct) AddBool(value bool) {
 	if value {
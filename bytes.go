@@ -0,0 +1,108 @@
+package json
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The base64 variant used to encode binary data as a JSON string.
+type BytesEncoding int
+
+const (
+	// Standard base64 with padding (RFC 4648 section 4).
+	BytesEncodingBase64 BytesEncoding = iota
+	// URL-safe base64 with padding (RFC 4648 section 5).
+	BytesEncodingBase64URL
+	// URL-safe base64 without padding.
+	BytesEncodingRawBase64URL
+)
+
+func (encoding BytesEncoding) base64Encoding() *base64.Encoding {
+	switch encoding {
+	case BytesEncodingBase64URL:
+		return base64.URLEncoding.Strict()
+	case BytesEncodingRawBase64URL:
+		return base64.RawURLEncoding.Strict()
+	}
+	return base64.StdEncoding.Strict()
+}
+
+func encodeBytes(value []byte, encoding BytesEncoding) string {
+	return encoding.base64Encoding().EncodeToString(value)
+}
+
+// Rejects padding that doesn't match the encoding, characters from other alphabets,
+// line breaks, and non-zero trailing bits.
+func decodeBytes(s string, encoding BytesEncoding) ([]byte, error) {
+	// The base64 package ignores line breaks.
+	if strings.ContainsAny(s, "\r\n") {
+		return nil, errors.New("invalid base64: contains line break")
+	}
+	decoded, err := encoding.base64Encoding().DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64: %w", err)
+	}
+	return decoded, nil
+}
+
+// Set a member with a JSON string value with the base64 encoded value.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetBytes(key string, value []byte, encoding BytesEncoding) {
+	object.SetString(key, encodeBytes(value, encoding))
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON string,
+// or the JSON string isn't strictly encoded with the base64 variant.
+func (object *ObjectStruct) GetBytes(key string, encoding BytesEncoding) ([]byte, error) {
+	value, err := object.GetString(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get string: %w", err)
+	}
+	decoded, err := decodeBytes(value, encoding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bytes: %w", err)
+	}
+	return decoded, nil
+}
+
+// Sets a JSON string value with the base64 encoded value at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetBytes(index int, value []byte, encoding BytesEncoding) {
+	array.SetString(index, encodeBytes(value, encoding))
+}
+
+// Appends a JSON string value with the base64 encoded value at the end of the array.
+func (array *ArrayStruct) AddBytes(value []byte, encoding BytesEncoding) {
+	array.AddString(encodeBytes(value, encoding))
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON string,
+// or the JSON string isn't strictly encoded with the base64 variant.
+func (array *ArrayStruct) GetBytes(index int, encoding BytesEncoding) ([]byte, error) {
+	value, err := array.GetString(index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get string: %w", err)
+	}
+	decoded, err := decodeBytes(value, encoding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bytes: %w", err)
+	}
+	return decoded, nil
+}
+
+// Encodes the name to a JSON string and value to a base64 encoded JSON string, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddBytes(name string, value []byte, encoding BytesEncoding) {
+	objectBuilder.AddString(name, encodeBytes(value, encoding))
+}
+
+// Encodes the value to a base64 encoded JSON string and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddBytes(value []byte, encoding BytesEncoding) {
+	arrayBuilder.AddString(encodeBytes(value, encoding))
+}
@@ -0,0 +1,60 @@
+package json
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGetBytes(t *testing.T) {
+	successCases := []bytesTestCaseStruct{
+		{"+/8=", BytesEncodingBase64, []byte{0xfb, 0xff}},
+		{"-_8=", BytesEncodingBase64URL, []byte{0xfb, 0xff}},
+		{"-_8", BytesEncodingRawBase64URL, []byte{0xfb, 0xff}},
+		{"", BytesEncodingBase64, []byte{}},
+		{"aGVsbG8=", BytesEncodingBase64, []byte("hello")},
+	}
+	for _, c := range successCases {
+		array := NewArray()
+		array.AddString(c.encoded)
+		decoded, err := array.GetBytes(0, c.encoding)
+		if err != nil {
+			t.Errorf("error on input %s: %s", c.encoded, err)
+			continue
+		}
+		if !bytes.Equal(decoded, c.decoded) {
+			t.Errorf("unexpected output on input %s: %v", c.encoded, decoded)
+			continue
+		}
+		array.AddBytes(c.decoded, c.encoding)
+		if encoded, _ := array.GetString(1); encoded != c.encoded {
+			t.Errorf("unexpected encoding on input %s: %s", c.encoded, encoded)
+		}
+	}
+
+	failCases := []bytesTestCaseStruct{
+		// Mixed alphabets.
+		{"+_8=", BytesEncodingBase64, nil},
+		{"-/8=", BytesEncodingBase64URL, nil},
+		// Missing or unexpected padding.
+		{"+/8", BytesEncodingBase64, nil},
+		{"-_8=", BytesEncodingRawBase64URL, nil},
+		// Non-zero trailing bits.
+		{"+/9=", BytesEncodingBase64, nil},
+		// Line breaks.
+		{"aGVs\nbG8=", BytesEncodingBase64, nil},
+	}
+	for _, c := range failCases {
+		array := NewArray()
+		array.AddString(c.encoded)
+		_, err := array.GetBytes(0, c.encoding)
+		if err == nil {
+			t.Errorf("expected error on input %q", c.encoded)
+		}
+	}
+}
+
+type bytesTestCaseStruct struct {
+	encoded  string
+	encoding BytesEncoding
+	decoded  []byte
+}
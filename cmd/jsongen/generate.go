@@ -0,0 +1,612 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const jsonImportPath = "github.com/pilcrowonpaper/go-json"
+
+const generatedHeader = "// Code generated by jsongen. DO NOT EDIT."
+
+type fieldKind int
+
+const (
+	// A type with builder and getter methods (e.g. int64 with AddInt64() and GetInt64()).
+	fieldKindBasic fieldKind = iota
+	fieldKindBytes
+	fieldKindDuration
+	fieldKindObject
+	fieldKindArray
+	fieldKindValue
+	// A struct type in the same package with EncodeJSON() and DecodeJSON() methods.
+	fieldKindStruct
+	fieldKindPointer
+	fieldKindSlice
+)
+
+type fieldTypeStruct struct {
+	kind fieldKind
+	// The builder and getter method suffix of basic types.
+	method string
+	// True if the builder method returns an error.
+	fallible bool
+	// The Go type of basic types returned by the getter (e.g. "int64").
+	base string
+	// The name of a named type in the same package.
+	named string
+	elem  *fieldTypeStruct
+}
+
+// Returns true if the type is encoded as null when nil.
+func (fieldType *fieldTypeStruct) nullable() bool {
+	switch fieldType.kind {
+	case fieldKindBytes, fieldKindPointer, fieldKindSlice:
+		return true
+	case fieldKindBasic:
+		return strings.HasPrefix(fieldType.base, "*")
+	}
+	return false
+}
+
+type fieldStruct struct {
+	goName    string
+	name      string
+	omitEmpty bool
+	fieldType *fieldTypeStruct
+}
+
+var basicTypes = map[string]*fieldTypeStruct{
+	"string":  {kind: fieldKindBasic, method: "String", base: "string"},
+	"bool":    {kind: fieldKindBasic, method: "Bool", base: "bool"},
+	"int":     {kind: fieldKindBasic, method: "Int", base: "int"},
+	"int32":   {kind: fieldKindBasic, method: "Int32", base: "int32"},
+	"int64":   {kind: fieldKindBasic, method: "Int64", base: "int64"},
+	"uint":    {kind: fieldKindBasic, method: "Uint", base: "uint"},
+	"uint8":   {kind: fieldKindBasic, method: "Uint8", base: "uint8"},
+	"byte":    {kind: fieldKindBasic, method: "Uint8", base: "uint8"},
+	"uint16":  {kind: fieldKindBasic, method: "Uint16", base: "uint16"},
+	"uint32":  {kind: fieldKindBasic, method: "Uint32", base: "uint32"},
+	"uint64":  {kind: fieldKindBasic, method: "Uint64", base: "uint64"},
+	"float32": {kind: fieldKindBasic, method: "Float32", fallible: true, base: "float32"},
+	"float64": {kind: fieldKindBasic, method: "Float64", fallible: true, base: "float64"},
+}
+
+type packageStruct struct {
+	name  string
+	types map[string]*ast.TypeSpec
+	// The imports of the file declaring each type, by local name.
+	imports map[*ast.TypeSpec]map[string]string
+}
+
+// Parses the non-test Go files of a directory, skipping files generated by jsongen.
+func parsePackage(dir string) (*packageStruct, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	fileSet := token.NewFileSet()
+	pkg := &packageStruct{types: map[string]*ast.TypeSpec{}, imports: map[*ast.TypeSpec]map[string]string{}}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, filepath.Join(dir, entry.Name()), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if isGeneratedFile(file) {
+			continue
+		}
+		if pkg.name == "" {
+			pkg.name = file.Name.Name
+		} else if pkg.name != file.Name.Name {
+			return nil, fmt.Errorf("multiple packages in %s: %s and %s", dir, pkg.name, file.Name.Name)
+		}
+		imports := map[string]string{}
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if path == jsonImportPath {
+				name = "json"
+			}
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = path
+		}
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				pkg.types[typeSpec.Name.Name] = typeSpec
+				pkg.imports[typeSpec] = imports
+			}
+		}
+	}
+	if pkg.name == "" {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return pkg, nil
+}
+
+func isGeneratedFile(file *ast.File) bool {
+	for _, comment := range file.Comments {
+		if comment.Pos() >= file.Package {
+			break
+		}
+		for _, line := range comment.List {
+			if line.Text == generatedHeader {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns the fields of a struct type in the package.
+func (pkg *packageStruct) structFields(typeName string) ([]fieldStruct, error) {
+	typeSpec, ok := pkg.types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("type %s not found", typeName)
+	}
+	if typeSpec.TypeParams != nil {
+		return nil, fmt.Errorf("generic type %s is not supported", typeName)
+	}
+	structType, ok := typeSpec.Type.(*ast.StructType)
+	if !ok {
+		return nil, fmt.Errorf("type %s is not a struct", typeName)
+	}
+	fields := []fieldStruct{}
+	names := map[string]bool{}
+	for _, astField := range structType.Fields.List {
+		if len(astField.Names) == 0 {
+			return nil, fmt.Errorf("%s: embedded field %s is not supported", typeName, exprString(astField.Type))
+		}
+		tag := ""
+		if astField.Tag != nil {
+			unquoted, _ := strconv.Unquote(astField.Tag.Value)
+			tag = reflect.StructTag(unquoted).Get("json")
+		}
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		omitEmpty := false
+		for option := range strings.SplitSeq(options, ",") {
+			switch option {
+			case "":
+			case "omitempty":
+				omitEmpty = true
+			default:
+				return nil, fmt.Errorf("%s: unsupported tag option %s", typeName, option)
+			}
+		}
+		for _, ident := range astField.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			fieldType, err := pkg.fieldType(astField.Type, pkg.imports[typeSpec])
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", typeName, ident.Name, err)
+			}
+			field := fieldStruct{goName: ident.Name, name: name, omitEmpty: omitEmpty, fieldType: fieldType}
+			if field.name == "" {
+				field.name = ident.Name
+			}
+			if names[field.name] {
+				return nil, fmt.Errorf("%s: duplicate member %s", typeName, field.name)
+			}
+			names[field.name] = true
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+func (pkg *packageStruct) fieldType(expr ast.Expr, imports map[string]string) (*fieldTypeStruct, error) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		typeSpec, ok := pkg.types[expr.Name]
+		if !ok {
+			basic, ok := basicTypes[expr.Name]
+			if !ok {
+				return nil, fmt.Errorf("unsupported type %s", expr.Name)
+			}
+			return basic, nil
+		}
+		if typeSpec.TypeParams != nil {
+			return nil, fmt.Errorf("generic type %s is not supported", expr.Name)
+		}
+		if _, ok := typeSpec.Type.(*ast.StructType); ok {
+			return &fieldTypeStruct{kind: fieldKindStruct, named: expr.Name}, nil
+		}
+		underlying, err := pkg.fieldType(typeSpec.Type, imports)
+		if err != nil {
+			return nil, fmt.Errorf("type %s: %w", expr.Name, err)
+		}
+		if underlying.kind != fieldKindBasic || underlying.nullable() || underlying.method == "Time" {
+			return nil, fmt.Errorf("type %s: only named types of strings, booleans, and numbers are supported", expr.Name)
+		}
+		named := *underlying
+		named.named = expr.Name
+		return &named, nil
+	case *ast.SelectorExpr:
+		packageIdent, ok := expr.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+		switch imports[packageIdent.Name] + "." + expr.Sel.Name {
+		case "time.Time":
+			return &fieldTypeStruct{kind: fieldKindBasic, method: "Time", base: "time.Time"}, nil
+		case "time.Duration":
+			return &fieldTypeStruct{kind: fieldKindDuration}, nil
+		case jsonImportPath + ".ObjectStruct":
+			return &fieldTypeStruct{kind: fieldKindObject}, nil
+		case jsonImportPath + ".ArrayStruct":
+			return &fieldTypeStruct{kind: fieldKindArray}, nil
+		case jsonImportPath + ".ValueStruct":
+			return &fieldTypeStruct{kind: fieldKindValue}, nil
+		}
+	case *ast.StarExpr:
+		if selector, ok := expr.X.(*ast.SelectorExpr); ok {
+			if packageIdent, ok := selector.X.(*ast.Ident); ok && imports[packageIdent.Name] == "math/big" {
+				switch selector.Sel.Name {
+				case "Int":
+					return &fieldTypeStruct{kind: fieldKindBasic, method: "BigInt", base: "*big.Int"}, nil
+				case "Float":
+					return &fieldTypeStruct{kind: fieldKindBasic, method: "BigFloat", fallible: true, base: "*big.Float"}, nil
+				case "Rat":
+					return &fieldTypeStruct{kind: fieldKindBasic, method: "Rat", fallible: true, base: "*big.Rat"}, nil
+				}
+			}
+		}
+		elem, err := pkg.fieldType(expr.X, imports)
+		if err != nil {
+			return nil, err
+		}
+		if elem.nullable() {
+			return nil, fmt.Errorf("unsupported type %s", exprString(expr))
+		}
+		return &fieldTypeStruct{kind: fieldKindPointer, elem: elem}, nil
+	case *ast.ArrayType:
+		if expr.Len != nil {
+			break
+		}
+		elem, err := pkg.fieldType(expr.Elt, imports)
+		if err != nil {
+			return nil, err
+		}
+		if elem.kind == fieldKindBasic && elem.base == "uint8" && elem.named == "" {
+			return &fieldTypeStruct{kind: fieldKindBytes}, nil
+		}
+		if elem.nullable() {
+			return nil, fmt.Errorf("unsupported type %s", exprString(expr))
+		}
+		return &fieldTypeStruct{kind: fieldKindSlice, elem: elem}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", exprString(expr))
+}
+
+func exprString(expr ast.Expr) string {
+	var b bytes.Buffer
+	format.Node(&b, token.NewFileSet(), expr)
+	return b.String()
+}
+
+type generatorStruct struct {
+	b       *bytes.Buffer
+	imports map[string]bool
+}
+
+// Generates EncodeJSON() and DecodeJSON() methods for struct types in the package in dir.
+func generate(dir string, typeNames []string) ([]byte, error) {
+	pkg, err := parsePackage(dir)
+	if err != nil {
+		return nil, err
+	}
+	generator := &generatorStruct{b: &bytes.Buffer{}, imports: map[string]bool{jsonImportPath: true}}
+	for _, typeName := range typeNames {
+		fields, err := pkg.structFields(typeName)
+		if err != nil {
+			return nil, err
+		}
+		generator.writeEncode(typeName, fields)
+		generator.writeDecode(typeName, fields)
+	}
+
+	var output bytes.Buffer
+	fmt.Fprintf(&output, "%s\n\npackage %s\n\nimport (\n", generatedHeader, pkg.name)
+	// Standard library imports are grouped before other imports like goimports.
+	standardImports, otherImports := []string{}, []string{}
+	for path := range generator.imports {
+		firstElement, _, _ := strings.Cut(path, "/")
+		if strings.Contains(firstElement, ".") {
+			otherImports = append(otherImports, path)
+		} else {
+			standardImports = append(standardImports, path)
+		}
+	}
+	slices.Sort(standardImports)
+	slices.Sort(otherImports)
+	for _, path := range standardImports {
+		fmt.Fprintf(&output, "%s\n", strconv.Quote(path))
+	}
+	if len(standardImports) > 0 && len(otherImports) > 0 {
+		output.WriteString("\n")
+	}
+	for _, path := range otherImports {
+		fmt.Fprintf(&output, "%s\n", strconv.Quote(path))
+	}
+	output.WriteString(")\n")
+	output.Write(generator.b.Bytes())
+	formatted, err := format.Source(output.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+func (generator *generatorStruct) printf(format string, args ...any) {
+	fmt.Fprintf(generator.b, format, args...)
+}
+
+func receiverName(typeName string) string {
+	name := strings.ToLower(typeName[:1]) + typeName[1:]
+	switch name {
+	case "object", "objectBuilder", "array", "arrayBuilder", "element", "value", "err", "i", "stringCharacterEscapingBehavior", "json", "fmt", "big", "time":
+		return name + "Value"
+	}
+	if token.IsKeyword(name) {
+		return name + "Value"
+	}
+	return name
+}
+
+func (generator *generatorStruct) writeEncode(typeName string, fields []fieldStruct) {
+	receiver := receiverName(typeName)
+	generator.printf("\n// Encodes the struct to a JSON object.\n")
+	if slices.ContainsFunc(fields, func(field fieldStruct) bool { return hasFallible(field.fieldType) }) {
+		generator.printf("// Panics if a number cannot be encoded (e.g. a NaN float).\n")
+	}
+	generator.printf("func (%s %s) EncodeJSON(stringCharacterEscapingBehavior json.StringCharacterEscapingBehaviorInterface) string {\n", receiver, typeName)
+	generator.printf("objectBuilder := json.NewObjectBuilder(stringCharacterEscapingBehavior)\n")
+	for _, field := range fields {
+		x := receiver + "." + field.goName
+		condition := emptyCheck(field.fieldType, x)
+		if field.omitEmpty && condition != "" {
+			// Non-empty values aren't nil.
+			generator.printf("if %s {\n", condition)
+			generator.writeEncodeValue(field.fieldType, x, "objectBuilder", strconv.Quote(field.name), field.name, false)
+			generator.printf("}\n")
+			continue
+		}
+		generator.writeEncodeValue(field.fieldType, x, "objectBuilder", strconv.Quote(field.name), field.name, true)
+	}
+	generator.printf("return objectBuilder.Done()\n}\n")
+}
+
+func hasFallible(fieldType *fieldTypeStruct) bool {
+	if fieldType.elem != nil {
+		return hasFallible(fieldType.elem)
+	}
+	return fieldType.fallible
+}
+
+// Returns a condition that is true if the value isn't empty,
+// or an empty string if the value is never empty.
+func emptyCheck(fieldType *fieldTypeStruct, x string) string {
+	switch fieldType.kind {
+	case fieldKindBytes, fieldKindSlice:
+		return fmt.Sprintf("len(%s) != 0", x)
+	case fieldKindPointer:
+		return x + " != nil"
+	case fieldKindDuration:
+		return x + " != 0"
+	case fieldKindBasic:
+		switch {
+		case fieldType.nullable():
+			return x + " != nil"
+		case fieldType.base == "string":
+			return x + ` != ""`
+		case fieldType.base == "bool":
+			return x
+		case fieldType.base == "time.Time":
+			return ""
+		}
+		return x + " != 0"
+	}
+	return ""
+}
+
+// Writes code adding the value x with the builder.
+// The key is empty for array builders.
+// memberName is the name of the member used in panic messages.
+// If checkNull is true, nil values are encoded as null.
+func (generator *generatorStruct) writeEncodeValue(fieldType *fieldTypeStruct, x string, builder string, key string, memberName string, checkNull bool) {
+	args := func(values ...string) string {
+		if key != "" {
+			values = append([]string{key}, values...)
+		}
+		return strings.Join(values, ", ")
+	}
+	if checkNull && fieldType.nullable() {
+		generator.printf("if %s == nil {\n%s.AddNull(%s)\n} else {\n", x, builder, key)
+		defer generator.printf("}\n")
+	}
+	switch fieldType.kind {
+	case fieldKindBasic:
+		method, value := fieldType.method, x
+		if fieldType.named != "" {
+			value = fmt.Sprintf("%s(%s)", fieldType.base, x)
+		}
+		// ArrayBuilderStruct.AddInt32() takes a key.
+		if key == "" && method == "Int32" {
+			method, value = "Int64", fmt.Sprintf("int64(%s)", x)
+		}
+		if !fieldType.fallible {
+			generator.printf("%s.Add%s(%s)\n", builder, method, args(value))
+			return
+		}
+		generator.imports["fmt"] = true
+		generator.printf("if err := %s.Add%s(%s); err != nil {\n", builder, method, args(value))
+		generator.printf("panic(fmt.Errorf(%s, err))\n}\n", strconv.Quote("failed to encode member "+escapeFormat(memberName)+": %w"))
+	case fieldKindBytes:
+		generator.printf("%s.AddBytes(%s)\n", builder, args(x, "json.BytesEncodingBase64"))
+	case fieldKindDuration:
+		generator.printf("%s.AddDuration(%s)\n", builder, args(x, "json.DurationFormatString"))
+	case fieldKindObject, fieldKindArray, fieldKindValue:
+		generator.printf("%s.AddJSON(%s)\n", builder, args(methodReceiver(x)+".String(stringCharacterEscapingBehavior)"))
+	case fieldKindStruct:
+		generator.printf("%s.AddJSON(%s)\n", builder, args(methodReceiver(x)+".EncodeJSON(stringCharacterEscapingBehavior)"))
+	case fieldKindPointer:
+		generator.writeEncodeValue(fieldType.elem, "*"+x, builder, key, memberName, false)
+	case fieldKindSlice:
+		generator.printf("arrayBuilder := json.NewArrayBuilder(stringCharacterEscapingBehavior)\n")
+		generator.printf("for _, element := range %s {\n", x)
+		generator.writeEncodeValue(fieldType.elem, "element", "arrayBuilder", "", memberName, false)
+		generator.printf("}\n")
+		generator.printf("%s.AddJSON(%s)\n", builder, args("arrayBuilder.Done()"))
+	}
+}
+
+// Methods can be called on pointers without dereferencing them.
+func methodReceiver(x string) string {
+	return strings.TrimPrefix(x, "*")
+}
+
+func escapeFormat(s string) string {
+	return strings.ReplaceAll(s, "%", "%%")
+}
+
+func (generator *generatorStruct) writeDecode(typeName string, fields []fieldStruct) {
+	receiver := receiverName(typeName)
+	generator.printf("\n// Decodes a JSON object into the struct.\n")
+	generator.printf("// Members of fields without the omitempty option are required.\n")
+	generator.printf("// Returns an error wrapping json.ErrNotFound if a required member is missing,\n")
+	generator.printf("// or an error if a member has an invalid value.\n")
+	generator.printf("func (%s *%s) DecodeJSON(object json.ObjectStruct) error {\n", receiver, typeName)
+	for _, field := range fields {
+		x := receiver + "." + field.goName
+		key := strconv.Quote(field.name)
+		switch {
+		case field.omitEmpty:
+			generator.printf("if object.Has(%s) {\n", key)
+		case field.fieldType.nullable():
+			// The null check declares its own scope.
+			generator.writeDecodeValue(field.fieldType, x, "object", key, field.name, false)
+			continue
+		default:
+			generator.printf("{\n")
+		}
+		generator.writeDecodeValue(field.fieldType, x, "object", key, field.name, false)
+		generator.printf("}\n")
+	}
+	generator.printf("return nil\n}\n")
+}
+
+// Writes code getting the value with the getter of source (an object or array) and assigning it to x.
+// key is the member name or element index.
+// memberName is the name of the member used in error messages.
+func (generator *generatorStruct) writeDecodeValue(fieldType *fieldTypeStruct, x string, source string, key string, memberName string, isElement bool) {
+	returnError := "return err\n"
+	if isElement {
+		generator.imports["fmt"] = true
+		returnError = fmt.Sprintf("return fmt.Errorf(%s, err)\n", strconv.Quote("member "+escapeFormat(memberName)+": %w"))
+	}
+	if fieldType.nullable() {
+		generator.printf("if %s.ExistsAndIsNull(%s) {\n%s = nil\n} else {\n", source, key, x)
+		defer generator.printf("}\n")
+	}
+	switch fieldType.kind {
+	case fieldKindBasic:
+		generator.printf("value, err := %s.Get%s(%s)\n", source, fieldType.method, key)
+		generator.printf("if err != nil {\n%s}\n", returnError)
+		if fieldType.named != "" {
+			generator.printf("%s = %s(value)\n", x, fieldType.named)
+		} else {
+			generator.printf("%s = value\n", x)
+		}
+	case fieldKindBytes:
+		generator.printf("value, err := %s.GetBytes(%s, json.BytesEncodingBase64)\n", source, key)
+		generator.printf("if err != nil {\n%s}\n%s = value\n", returnError, x)
+	case fieldKindDuration:
+		generator.printf("value, err := %s.GetDuration(%s, json.DurationFormatString)\n", source, key)
+		generator.printf("if err != nil {\n%s}\n%s = value\n", returnError, x)
+	case fieldKindObject:
+		generator.printf("value, err := %s.GetJSONObject(%s)\n", source, key)
+		generator.printf("if err != nil {\n%s}\n%s = value\n", returnError, x)
+	case fieldKindArray:
+		generator.printf("value, err := %s.GetJSONArray(%s)\n", source, key)
+		generator.printf("if err != nil {\n%s}\n%s = value\n", returnError, x)
+	case fieldKindValue:
+		generator.printf("value, err := %s.Get(%s)\n", source, key)
+		generator.printf("if err != nil {\n%s}\n%s = value\n", returnError, x)
+	case fieldKindStruct:
+		generator.imports["fmt"] = true
+		generator.printf("value, err := %s.GetJSONObject(%s)\n", source, key)
+		generator.printf("if err != nil {\n%s}\n", returnError)
+		generator.printf("err = %s.DecodeJSON(value)\n", methodReceiver(x))
+		if isElement {
+			generator.printf("if err != nil {\nreturn fmt.Errorf(%s, %s, err)\n}\n", strconv.Quote("member "+escapeFormat(memberName)+": element %d: %w"), key)
+		} else {
+			generator.printf("if err != nil {\nreturn fmt.Errorf(%s, err)\n}\n", strconv.Quote("member "+escapeFormat(memberName)+": %w"))
+		}
+	case fieldKindPointer:
+		generator.printf("%s = new(%s)\n", x, generator.typeString(fieldType.elem))
+		generator.writeDecodeValue(fieldType.elem, "*"+x, source, key, memberName, isElement)
+	case fieldKindSlice:
+		generator.printf("array, err := %s.GetJSONArray(%s)\n", source, key)
+		generator.printf("if err != nil {\n%s}\n", returnError)
+		generator.printf("%s = make(%s, array.Length)\n", x, generator.typeString(fieldType))
+		generator.printf("for i := range array.Length {\n")
+		generator.writeDecodeValue(fieldType.elem, x+"[i]", "array", "i", memberName, true)
+		generator.printf("}\n")
+	}
+}
+
+// Returns the Go type and adds the imports it requires.
+func (generator *generatorStruct) typeString(fieldType *fieldTypeStruct) string {
+	switch fieldType.kind {
+	case fieldKindBasic:
+		if fieldType.named != "" {
+			return fieldType.named
+		}
+		if strings.HasPrefix(fieldType.base, "*big.") {
+			generator.imports["math/big"] = true
+		}
+		if fieldType.base == "time.Time" {
+			generator.imports["time"] = true
+		}
+		return fieldType.base
+	case fieldKindBytes:
+		return "[]byte"
+	case fieldKindDuration:
+		generator.imports["time"] = true
+		return "time.Duration"
+	case fieldKindObject:
+		return "json.ObjectStruct"
+	case fieldKindArray:
+		return "json.ArrayStruct"
+	case fieldKindValue:
+		return "json.ValueStruct"
+	case fieldKindStruct:
+		return fieldType.named
+	case fieldKindPointer:
+		return "*" + generator.typeString(fieldType.elem)
+	}
+	return "[]" + generator.typeString(fieldType.elem)
+}
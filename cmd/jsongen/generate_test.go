@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// The generated code of internal/jsongentest must be up to date.
+func TestGenerate(t *testing.T) {
+	dir := filepath.Join("..", "..", "internal", "jsongentest")
+	generated, err := generate(dir, []string{"UserStruct", "AddressStruct"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := os.ReadFile(filepath.Join(dir, "types_jsongen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(generated) != string(expected) {
+		t.Errorf("generated code of %s is out of date", dir)
+	}
+}
+
+// Standard library imports must be grouped before the go-json import.
+func TestGenerateImports(t *testing.T) {
+	dir := t.TempDir()
+	source := "package p\n\nimport (\n\t\"math/big\"\n\t\"time\"\n)\n\ntype T struct {\n\tD *time.Duration\n\tN *big.Float\n}\n"
+	err := os.WriteFile(filepath.Join(dir, "t.go"), []byte(source), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated, err := generate(dir, []string{"T"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "import (\n\t\"fmt\"\n\t\"time\"\n\n\t\"github.com/pilcrowonpaper/go-json\"\n)\n"
+	if !strings.Contains(string(generated), expected) {
+		t.Errorf("unexpected imports: %s", generated)
+	}
+}
+
+func TestGenerateUnsupported(t *testing.T) {
+	failCases := []string{
+		"type T struct { M map[string]string }",
+		"type T struct { P **int }",
+		"type T struct { S [][]byte }",
+		"type E struct{}\ntype T struct { E }",
+		"type T struct { A int `json:\"a,string\"` }",
+		"type T struct { A int `json:\"a\"`; B int `json:\"a\"` }",
+		"type L []int\ntype T struct { A L }",
+		"type T int",
+	}
+	for _, source := range failCases {
+		dir := t.TempDir()
+		err := os.WriteFile(filepath.Join(dir, "t.go"), []byte("package p\n\n"+source+"\n"), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = generate(dir, []string{"T"})
+		if err == nil {
+			t.Errorf("expected error on source %s", source)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Jsongen generates reflection-free JSON encoders and decoders for struct types.
+//
+// For each type, jsongen generates a method encoding the struct with json.ObjectBuilderStruct:
+//
+//	func (t T) EncodeJSON(stringCharacterEscapingBehavior json.StringCharacterEscapingBehaviorInterface) string
+//
+// And a method decoding a json.ObjectStruct with the object getters:
+//
+//	func (t *T) DecodeJSON(object json.ObjectStruct) error
+//
+// Usage:
+//
+//	//go:generate go run github.com/pilcrowonpaper/go-json/cmd/jsongen -type UserStruct,AddressStruct
+//
+// Member names and options are set with "json" struct tags like [github.com/pilcrowonpaper/go-json.Marshal].
+// The omitempty and "-" options are supported.
+// Members of fields without the omitempty option are required when decoding.
+//
+// Supported field types are strings, booleans, integers, floats, *big.Int, *big.Float, *big.Rat,
+// time.Time (RFC 3339 strings), time.Duration (Go duration strings), []byte (standard base64 strings),
+// json.ObjectStruct, json.ArrayStruct, json.ValueStruct, named types of strings, booleans, and numbers,
+// and struct types in the same package with EncodeJSON() and DecodeJSON() methods,
+// as well as pointers and slices of these types.
+// Nil pointers and slices are encoded as null.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeNames := flag.String("type", "", "comma-separated list of struct type names (required)")
+	output := flag.String("output", "", "output file name (default <first type>_jsongen.go)")
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	types := strings.Split(*typeNames, ",")
+	generated, err := generate(dir, types)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "jsongen: %s\n", err)
+		os.Exit(1)
+	}
+
+	outputPath := *output
+	if outputPath == "" {
+		outputPath = strings.ToLower(types[0]) + "_jsongen.go"
+	}
+	if !filepath.IsAbs(outputPath) {
+		outputPath = filepath.Join(dir, outputPath)
+	}
+	err = os.WriteFile(outputPath, generated, 0o644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "jsongen: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,295 @@
+package json
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type DiffOptionsStruct struct {
+	// If true, array elements that were moved are reported with move operations
+	// instead of a remove and an add operation.
+	DetectArrayMoves bool
+}
+
+// Returns a JSON Patch (RFC 6902) that turns a into b when applied with [ApplyPatch].
+// Values are compared with [Equal] with the default options.
+// Objects are compared member by member and arrays are compared by their longest common subsequence.
+// Changes to the order of object members are not reported.
+func Diff(a ValueStruct, b ValueStruct, options DiffOptionsStruct) ArrayStruct {
+	patch := NewArray()
+	diffValues(&patch, a, b, "", options)
+	return patch
+}
+
+func diffValues(patch *ArrayStruct, a ValueStruct, b ValueStruct, path string, options DiffOptionsStruct) {
+	if a.kind == KindObject && b.kind == KindObject {
+		diffObjects(patch, a.object, b.object, path, options)
+		return
+	}
+	if a.kind == KindArray && b.kind == KindArray {
+		diffArrays(patch, a.array, b.array, path, options)
+		return
+	}
+	if !Equal(a, b, EqualityOptionsStruct{}) {
+		patch.AddJSONObject(newPatchOperation("replace", path, "", b))
+	}
+}
+
+func diffObjects(patch *ArrayStruct, a ObjectStruct, b ObjectStruct, path string, options DiffOptionsStruct) {
+	for _, key := range a.Keys {
+		if !b.Has(key) {
+			patch.AddJSONObject(newPatchOperation("remove", path+"/"+escapeJSONPointerToken(key), "", ValueStruct{}))
+		}
+	}
+	for _, key := range b.Keys {
+		memberPath := path + "/" + escapeJSONPointerToken(key)
+		bValue, _ := b.get(key)
+		aValue, ok := a.get(key)
+		if !ok {
+			patch.AddJSONObject(newPatchOperation("add", memberPath, "", bValue))
+			continue
+		}
+		diffValues(patch, aValue, bValue, memberPath, options)
+	}
+}
+
+const (
+	diffStepEqual = iota
+	diffStepDelete
+	diffStepInsert
+)
+
+type diffStepStruct struct {
+	kind   int
+	aIndex int
+	bIndex int
+}
+
+func diffArrays(patch *ArrayStruct, a ArrayStruct, b ArrayStruct, path string, options DiffOptionsStruct) {
+	aValues := make([]ValueStruct, a.Length)
+	for i := range a.Length {
+		aValues[i], _ = a.get(i)
+	}
+	bValues := make([]ValueStruct, b.Length)
+	for i := range b.Length {
+		bValues[i], _ = b.get(i)
+	}
+	steps := diffArraySteps(aValues, bValues)
+
+	// Maps deleted elements to equal inserted elements and vice versa.
+	movedTo := map[int]int{}
+	movedFrom := map[int]int{}
+	if options.DetectArrayMoves {
+		for _, deleteStep := range steps {
+			if deleteStep.kind != diffStepDelete {
+				continue
+			}
+			for _, insertStep := range steps {
+				if insertStep.kind != diffStepInsert {
+					continue
+				}
+				if _, ok := movedFrom[insertStep.bIndex]; ok {
+					continue
+				}
+				if Equal(aValues[deleteStep.aIndex], bValues[insertStep.bIndex], EqualityOptionsStruct{}) {
+					movedTo[deleteStep.aIndex] = insertStep.bIndex
+					movedFrom[insertStep.bIndex] = deleteStep.aIndex
+					break
+				}
+			}
+		}
+	}
+
+	// Simulates the array as the operations are applied to compute indexes.
+	// Elements are identified by their index in a, or -1 for inserted elements.
+	// All elements before index are either in their final position or will be moved later.
+	current := make([]int, a.Length)
+	for i := range a.Length {
+		current[i] = i
+	}
+	moved := map[int]bool{}
+	index := 0
+	for start := 0; start < len(steps); {
+		if steps[start].kind == diffStepEqual {
+			index++
+			start++
+			continue
+		}
+		end := start
+		var deletes, inserts []diffStepStruct
+		hasMove := false
+		for end < len(steps) && steps[end].kind != diffStepEqual {
+			if steps[end].kind == diffStepDelete {
+				deletes = append(deletes, steps[end])
+				if _, ok := movedTo[steps[end].aIndex]; ok {
+					hasMove = true
+				}
+			} else {
+				inserts = append(inserts, steps[end])
+				if _, ok := movedFrom[steps[end].bIndex]; ok {
+					hasMove = true
+				}
+			}
+			end++
+		}
+		start = end
+
+		if !hasMove {
+			// Replaced elements are diffed in place.
+			paired := min(len(deletes), len(inserts))
+			for i := range paired {
+				diffValues(patch, aValues[deletes[i].aIndex], bValues[inserts[i].bIndex], path+"/"+strconv.Itoa(index), options)
+				current[index] = -1
+				index++
+			}
+			for range deletes[paired:] {
+				patch.AddJSONObject(newPatchOperation("remove", path+"/"+strconv.Itoa(index), "", ValueStruct{}))
+				current = slices.Delete(current, index, index+1)
+			}
+			for _, step := range inserts[paired:] {
+				patch.AddJSONObject(newPatchOperation("add", path+"/"+strconv.Itoa(index), "", bValues[step.bIndex]))
+				current = slices.Insert(current, index, -1)
+				index++
+			}
+			continue
+		}
+
+		for _, step := range deletes {
+			if _, ok := movedTo[step.aIndex]; ok {
+				// Left in place until the matching insert.
+				if !moved[step.aIndex] {
+					index++
+				}
+				continue
+			}
+			patch.AddJSONObject(newPatchOperation("remove", path+"/"+strconv.Itoa(index), "", ValueStruct{}))
+			current = slices.Delete(current, index, index+1)
+		}
+		for _, step := range inserts {
+			aIndex, ok := movedFrom[step.bIndex]
+			if !ok {
+				patch.AddJSONObject(newPatchOperation("add", path+"/"+strconv.Itoa(index), "", bValues[step.bIndex]))
+				current = slices.Insert(current, index, -1)
+				index++
+				continue
+			}
+			from := slices.Index(current, aIndex)
+			current = slices.Delete(current, from, from+1)
+			to := index
+			if from < index {
+				to = index - 1
+			} else {
+				index++
+			}
+			current = slices.Insert(current, to, aIndex)
+			moved[aIndex] = true
+			if from != to {
+				patch.AddJSONObject(newPatchOperation("move", path+"/"+strconv.Itoa(to), path+"/"+strconv.Itoa(from), ValueStruct{}))
+			}
+		}
+	}
+}
+
+// Returns the steps of the shortest edit script between a and b using their longest common subsequence.
+func diffArraySteps(a []ValueStruct, b []ValueStruct) []diffStepStruct {
+	// lengths[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if Equal(a[i], b[j], EqualityOptionsStruct{}) {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	steps := []diffStepStruct{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if Equal(a[i], b[j], EqualityOptionsStruct{}) {
+			steps = append(steps, diffStepStruct{kind: diffStepEqual, aIndex: i, bIndex: j})
+			i++
+			j++
+		} else if lengths[i+1][j] >= lengths[i][j+1] {
+			steps = append(steps, diffStepStruct{kind: diffStepDelete, aIndex: i})
+			i++
+		} else {
+			steps = append(steps, diffStepStruct{kind: diffStepInsert, bIndex: j})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		steps = append(steps, diffStepStruct{kind: diffStepDelete, aIndex: i})
+	}
+	for ; j < len(b); j++ {
+		steps = append(steps, diffStepStruct{kind: diffStepInsert, bIndex: j})
+	}
+	return steps
+}
+
+func newPatchOperation(op string, path string, from string, value ValueStruct) ObjectStruct {
+	operation := NewObject()
+	operation.SetString("op", op)
+	if op == "move" || op == "copy" {
+		operation.SetString("from", from)
+	}
+	operation.SetString("path", path)
+	if op == "add" || op == "replace" || op == "test" {
+		operation.Set("value", value.Clone())
+	}
+	return operation
+}
+
+// Formats a JSON Patch (RFC 6902) for logging, with one operation per line.
+// For example:
+//
+//	add /tags/1: "admin"
+//	remove /email
+//	replace /name: "pilcrow"
+//	move /tags/0 -> /tags/2
+//
+// Values are encoded with ValueStruct.String().
+// Invalid operations are formatted as JSON objects.
+func FormatPatch(patch ArrayStruct, stringCharacterEscapingBehavior StringCharacterEscapingBehaviorInterface) string {
+	lines := make([]string, 0, patch.Length)
+	for i := range patch.Length {
+		element, _ := patch.get(i)
+		operation, err := element.GetJSONObject()
+		if err != nil {
+			lines = append(lines, element.String(stringCharacterEscapingBehavior))
+			continue
+		}
+		op, _ := operation.GetString("op")
+		path, pathErr := operation.GetString("path")
+		if pathErr != nil {
+			lines = append(lines, operation.String(stringCharacterEscapingBehavior))
+			continue
+		}
+		switch op {
+		case "add", "replace", "test":
+			value, err := operation.Get("value")
+			if err != nil {
+				lines = append(lines, operation.String(stringCharacterEscapingBehavior))
+				continue
+			}
+			lines = append(lines, op+" "+path+": "+value.String(stringCharacterEscapingBehavior))
+		case "remove":
+			lines = append(lines, op+" "+path)
+		case "move", "copy":
+			from, err := operation.GetString("from")
+			if err != nil {
+				lines = append(lines, operation.String(stringCharacterEscapingBehavior))
+				continue
+			}
+			lines = append(lines, op+" "+from+" -> "+path)
+		default:
+			lines = append(lines, operation.String(stringCharacterEscapingBehavior))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,85 @@
+package json
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	testCases := []diffTestCaseStruct{
+		{`{"a":1,"b":2}`, `{"a":1,"b":2}`, false, ``},
+		{`{"a":1,"b":2}`, `{"a":1,"c":3}`, false, "remove /b\nadd /c: 3"},
+		{`{"a":{"b":[1,2,3]}}`, `{"a":{"b":[1,3]}}`, false, "remove /a/b/1"},
+		{`{"a":[1,2,3]}`, `{"a":[0,1,2,3]}`, false, "add /a/0: 0"},
+		{`{"a":[{"x":1},2]}`, `{"a":[{"x":2},2]}`, false, "replace /a/0/x: 2"},
+		{`{"a":[1,2,3,4]}`, `{"a":[2,3,4,1]}`, false, "remove /a/0\nadd /a/3: 1"},
+		{`{"a":[1,2,3,4]}`, `{"a":[2,3,4,1]}`, true, "move /a/0 -> /a/3"},
+		{`{"a":[1,2,3,4]}`, `{"a":[4,1,2,3]}`, true, "move /a/3 -> /a/0"},
+		{`{"a~b":"x"}`, `{"a~b":"y"}`, false, `replace /a~0b: "y"`},
+	}
+	for _, c := range testCases {
+		a, err := ParseObject(c.a)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.a, err)
+		}
+		b, err := ParseObject(c.b)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.b, err)
+		}
+		patch := Diff(NewJSONObjectValue(a), NewJSONObjectValue(b), DiffOptionsStruct{DetectArrayMoves: c.detectArrayMoves})
+		got := FormatPatch(patch, MinimalStringCharacterEscapingBehavior)
+		if got != c.expected {
+			t.Errorf("unexpected output on input %s, %s: %s", c.a, c.b, got)
+			continue
+		}
+		result, err := ApplyPatch(NewJSONObjectValue(a), patch)
+		if err != nil {
+			t.Errorf("failed to apply patch on input %s, %s: %s", c.a, c.b, err)
+			continue
+		}
+		if !Equal(result, NewJSONObjectValue(b), EqualityOptionsStruct{}) {
+			t.Errorf("unexpected patch result on input %s, %s", c.a, c.b)
+			continue
+		}
+	}
+}
+
+func TestDiffRandomArrays(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := range 500 {
+		a := randomDiffTestArray(r)
+		b := randomDiffTestArray(r)
+		for _, detectArrayMoves := range []bool{false, true} {
+			patch := Diff(NewJSONArrayValue(a), NewJSONArrayValue(b), DiffOptionsStruct{DetectArrayMoves: detectArrayMoves})
+			result, err := ApplyPatch(NewJSONArrayValue(a), patch)
+			if err != nil {
+				t.Fatalf("failed to apply patch %d: %s", i, err)
+			}
+			if !Equal(result, NewJSONArrayValue(b), EqualityOptionsStruct{}) {
+				t.Fatalf("unexpected patch result %d: %s, %s, %s", i, a.String(MinimalStringCharacterEscapingBehavior), b.String(MinimalStringCharacterEscapingBehavior), FormatPatch(patch, MinimalStringCharacterEscapingBehavior))
+			}
+		}
+	}
+}
+
+func randomDiffTestArray(r *rand.Rand) ArrayStruct {
+	array := NewArray()
+	for range r.Intn(8) {
+		if r.Intn(4) == 0 {
+			object := NewObject()
+			object.SetInt("x", r.Intn(3))
+			array.AddJSONObject(object)
+		} else {
+			array.AddNumber(strconv.Itoa(r.Intn(5)))
+		}
+	}
+	return array
+}
+
+type diffTestCaseStruct struct {
+	a                string
+	b                string
+	detectArrayMoves bool
+	expected         string
+}
@@ -0,0 +1,132 @@
+package json
+
+import (
+	"encoding"
+	stdjson "encoding/json"
+)
+
+// encoding/json escapes <, >, and & in the output of MarshalJSON() by default.
+// The output of encoding/json is byte-identical to String() with MinimalStringCharacterEscapingBehavior
+// only when HTML escaping is disabled with Encoder.SetEscapeHTML(false).
+// U+2028 and U+2029 are always escaped by encoding/json.
+
+var (
+	_ stdjson.Marshaler        = ObjectStruct{}
+	_ stdjson.Unmarshaler      = (*ObjectStruct)(nil)
+	_ encoding.TextMarshaler   = ObjectStruct{}
+	_ encoding.TextUnmarshaler = (*ObjectStruct)(nil)
+	_ stdjson.Marshaler        = ArrayStruct{}
+	_ stdjson.Unmarshaler      = (*ArrayStruct)(nil)
+	_ encoding.TextMarshaler   = ArrayStruct{}
+	_ encoding.TextUnmarshaler = (*ArrayStruct)(nil)
+	_ stdjson.Marshaler        = ValueStruct{}
+	_ stdjson.Unmarshaler      = (*ValueStruct)(nil)
+	_ encoding.TextMarshaler   = ValueStruct{}
+	_ encoding.TextUnmarshaler = (*ValueStruct)(nil)
+)
+
+// Implements encoding/json.Marshaler.
+// Encodes the object with ObjectStruct.String() and MinimalStringCharacterEscapingBehavior.
+// Uses a value receiver so objects stored by value in structs are encoded as well.
+func (object ObjectStruct) MarshalJSON() ([]byte, error) {
+	return []byte(object.String(MinimalStringCharacterEscapingBehavior)), nil
+}
+
+// Implements encoding/json.Unmarshaler.
+// Parses the data with ParseObject(). JSON null is ignored.
+// Returns ErrFrozen if the object is frozen.
+func (object *ObjectStruct) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	return object.UnmarshalText(data)
+}
+
+// Implements encoding.TextMarshaler.
+// Encodes the object with ObjectStruct.String() and MinimalStringCharacterEscapingBehavior.
+func (object ObjectStruct) MarshalText() ([]byte, error) {
+	return object.MarshalJSON()
+}
+
+// Implements encoding.TextUnmarshaler.
+// Parses the text with ParseObject().
+// Returns ErrFrozen if the object is frozen.
+func (object *ObjectStruct) UnmarshalText(text []byte) error {
+	if object.frozen {
+		return ErrFrozen
+	}
+	parsed, err := ParseObject(string(text))
+	if err != nil {
+		return err
+	}
+	*object = parsed
+	return nil
+}
+
+// Implements encoding/json.Marshaler.
+// Encodes the array with ArrayStruct.String() and MinimalStringCharacterEscapingBehavior.
+// Uses a value receiver so arrays stored by value in structs are encoded as well.
+func (array ArrayStruct) MarshalJSON() ([]byte, error) {
+	return []byte(array.String(MinimalStringCharacterEscapingBehavior)), nil
+}
+
+// Implements encoding/json.Unmarshaler.
+// Parses the data with ParseArray(). JSON null is ignored.
+// Returns ErrFrozen if the array is frozen.
+func (array *ArrayStruct) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	return array.UnmarshalText(data)
+}
+
+// Implements encoding.TextMarshaler.
+// Encodes the array with ArrayStruct.String() and MinimalStringCharacterEscapingBehavior.
+func (array ArrayStruct) MarshalText() ([]byte, error) {
+	return array.MarshalJSON()
+}
+
+// Implements encoding.TextUnmarshaler.
+// Parses the text with ParseArray().
+// Returns ErrFrozen if the array is frozen.
+func (array *ArrayStruct) UnmarshalText(text []byte) error {
+	if array.frozen {
+		return ErrFrozen
+	}
+	parsed, err := ParseArray(string(text))
+	if err != nil {
+		return err
+	}
+	*array = parsed
+	return nil
+}
+
+// Implements encoding/json.Marshaler.
+// Encodes the value with ValueStruct.String() and MinimalStringCharacterEscapingBehavior.
+// Uses a value receiver so values stored by value in structs are encoded as well.
+func (value ValueStruct) MarshalJSON() ([]byte, error) {
+	return []byte(value.String(MinimalStringCharacterEscapingBehavior)), nil
+}
+
+// Implements encoding/json.Unmarshaler.
+// Parses the data with ParseValue(). JSON null is parsed as a null value.
+func (value *ValueStruct) UnmarshalJSON(data []byte) error {
+	return value.UnmarshalText(data)
+}
+
+// Implements encoding.TextMarshaler.
+// Encodes the value with ValueStruct.String() and MinimalStringCharacterEscapingBehavior.
+func (value ValueStruct) MarshalText() ([]byte, error) {
+	return value.MarshalJSON()
+}
+
+// Implements encoding.TextUnmarshaler.
+// Parses the text with ParseValue().
+func (value *ValueStruct) UnmarshalText(text []byte) error {
+	parsed, err := ParseValue(string(text))
+	if err != nil {
+		return err
+	}
+	*value = parsed
+	return nil
+}
@@ -0,0 +1,93 @@
+package json
+
+import (
+	"bytes"
+	stdjson "encoding/json"
+	"testing"
+)
+
+func TestStandardLibraryInteroperability(t *testing.T) {
+	type documentStruct struct {
+		Object ObjectStruct `json:"object"`
+		Array  *ArrayStruct `json:"array"`
+		Value  ValueStruct  `json:"value"`
+	}
+	data := `{"object":{"a":"<b> & ","c":1.50},"array":[1,{"d":null}],"value":-1e3}`
+
+	var document documentStruct
+	err := stdjson.Unmarshal([]byte(data), &document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := document.Object.String(MinimalStringCharacterEscapingBehavior); got != `{"a":"<b> & ","c":1.50}` {
+		t.Errorf("unexpected object: %s", got)
+	}
+	if got := document.Array.String(MinimalStringCharacterEscapingBehavior); got != `[1,{"d":null}]` {
+		t.Errorf("unexpected array: %s", got)
+	}
+	if got := document.Value.String(MinimalStringCharacterEscapingBehavior); got != `-1e3` {
+		t.Errorf("unexpected value: %s", got)
+	}
+
+	var buffer bytes.Buffer
+	encoder := stdjson.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	err = encoder.Encode(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"object":` + document.Object.String(MinimalStringCharacterEscapingBehavior) +
+		`,"array":` + document.Array.String(MinimalStringCharacterEscapingBehavior) +
+		`,"value":-1e3}` + "\n"
+	if buffer.String() != expected {
+		t.Errorf("unexpected output: %s", buffer.String())
+	}
+
+	encoded, err := stdjson.Marshal(document.Object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"a":"\u003cb\u003e \u0026 ","c":1.50}` {
+		t.Errorf("unexpected output: %s", encoded)
+	}
+
+	frozen := NewObject()
+	frozen.Freeze()
+	if err := frozen.UnmarshalJSON([]byte(`{}`)); err != ErrFrozen {
+		t.Errorf("expected ErrFrozen, got %v", err)
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	successCases := []parseValueTestCaseStruct{
+		{` 1 `, `1`},
+		{`-0.5e-3`, `-0.5e-3`},
+		{`"a\n"`, `"a\n"`},
+		{`true`, `true`},
+		{` null`, `null`},
+		{`[1, {"a": []}]`, `[1,{"a":[]}]`},
+	}
+	for _, c := range successCases {
+		value, err := ParseValue(c.input)
+		if err != nil {
+			t.Errorf("error on input %s: %s", c.input, err)
+			continue
+		}
+		if got := value.String(MinimalStringCharacterEscapingBehavior); got != c.expected {
+			t.Errorf("unexpected output on input %s: %s", c.input, got)
+		}
+	}
+
+	failCases := []string{``, `1 2`, `tru`, `-`, `1.`, `1.e1`, `[1.]`, `1e`, `{"a":1}}`, `nul`}
+	for _, input := range failCases {
+		_, err := ParseValue(input)
+		if err == nil {
+			t.Errorf("expected error on input %s", input)
+		}
+	}
+}
+
+type parseValueTestCaseStruct struct {
+	input    string
+	expected string
+}
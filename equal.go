@@ -0,0 +1,130 @@
+package json
+
+import (
+	"strconv"
+)
+
+type EqualityOptionsStruct struct {
+	// If true, object members must appear in the same order.
+	KeyOrderSensitive bool
+	// If true, numbers are compared by their numeric value (e.g. 1.0 equals 1 and 1e2 equals 100)
+	// instead of their lexeme.
+	CompareNumbersByValue bool
+	// If true, array elements are compared ignoring their order.
+	// Duplicate elements must appear the same number of times.
+	ArraysAsMultisets bool
+}
+
+// Reports whether two JSON values are semantically equal.
+// Use [NewJSONObjectValue] and [NewJSONArrayValue] to compare objects and arrays.
+func Equal(a ValueStruct, b ValueStruct, options EqualityOptionsStruct) bool {
+	_, different := FindFirstDifference(a, b, options)
+	return !different
+}
+
+// Returns the JSON Pointer of the first location where the two JSON values differ
+// and true, or an empty string and false if the values are equal.
+// Object members are visited in the order of a's keys.
+func FindFirstDifference(a ValueStruct, b ValueStruct, options EqualityOptionsStruct) (string, bool) {
+	return findFirstDifference(a, b, options, "")
+}
+
+func findFirstDifference(a ValueStruct, b ValueStruct, options EqualityOptionsStruct, path string) (string, bool) {
+	if a.kind != b.kind {
+		return path, true
+	}
+	switch a.kind {
+	case KindString:
+		if a.s != b.s {
+			return path, true
+		}
+	case KindNumber:
+		if options.CompareNumbersByValue {
+			if compareNumbers(a.s, b.s) != 0 {
+				return path, true
+			}
+		} else if a.s != b.s {
+			return path, true
+		}
+	case KindBool:
+		if a.b != b.b {
+			return path, true
+		}
+	case KindObject:
+		return findFirstObjectDifference(a.object, b.object, options, path)
+	case KindArray:
+		if options.ArraysAsMultisets {
+			return findFirstMultisetDifference(a.array, b.array, options, path)
+		}
+		return findFirstArrayDifference(a.array, b.array, options, path)
+	}
+	return "", false
+}
+
+func findFirstObjectDifference(a ObjectStruct, b ObjectStruct, options EqualityOptionsStruct, path string) (string, bool) {
+	for i, key := range a.Keys {
+		memberPath := path + "/" + escapeJSONPointerToken(key)
+		if options.KeyOrderSensitive && (i >= len(b.Keys) || b.Keys[i] != key) {
+			return memberPath, true
+		}
+		aValue, _ := a.get(key)
+		bValue, ok := b.get(key)
+		if !ok {
+			return memberPath, true
+		}
+		if differencePath, different := findFirstDifference(aValue, bValue, options, memberPath); different {
+			return differencePath, true
+		}
+	}
+	for _, key := range b.Keys {
+		if !a.Has(key) {
+			return path + "/" + escapeJSONPointerToken(key), true
+		}
+	}
+	return "", false
+}
+
+func findFirstArrayDifference(a ArrayStruct, b ArrayStruct, options EqualityOptionsStruct, path string) (string, bool) {
+	for i := range min(a.Length, b.Length) {
+		aValue, _ := a.get(i)
+		bValue, _ := b.get(i)
+		elementPath := path + "/" + strconv.Itoa(i)
+		if differencePath, different := findFirstDifference(aValue, bValue, options, elementPath); different {
+			return differencePath, true
+		}
+	}
+	if a.Length != b.Length {
+		return path + "/" + strconv.Itoa(min(a.Length, b.Length)), true
+	}
+	return "", false
+}
+
+// Matches every element of a with a distinct equal element of b.
+// The path of the first element without a match is returned.
+func findFirstMultisetDifference(a ArrayStruct, b ArrayStruct, options EqualityOptionsStruct, path string) (string, bool) {
+	matched := make([]bool, b.Length)
+	for i := range a.Length {
+		aValue, _ := a.get(i)
+		found := false
+		for j := range b.Length {
+			if matched[j] {
+				continue
+			}
+			bValue, _ := b.get(j)
+			if _, different := findFirstDifference(aValue, bValue, options, ""); !different {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return path + "/" + strconv.Itoa(i), true
+		}
+	}
+	for j := range b.Length {
+		if !matched[j] {
+			return path + "/" + strconv.Itoa(j), true
+		}
+	}
+	return "", false
+}
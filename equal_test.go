@@ -0,0 +1,47 @@
+package json
+
+import (
+	"testing"
+)
+
+func TestFindFirstDifference(t *testing.T) {
+	testCases := []differenceTestCaseStruct{
+		{`{"a":1,"b":[true,null]}`, `{"a":1,"b":[true,null]}`, EqualityOptionsStruct{}, ""},
+		{`{"a":1,"b":2}`, `{"b":2,"a":1}`, EqualityOptionsStruct{}, ""},
+		{`{"a":1,"b":2}`, `{"b":2,"a":1}`, EqualityOptionsStruct{KeyOrderSensitive: true}, "/a"},
+		{`{"a":1.0}`, `{"a":1}`, EqualityOptionsStruct{}, "/a"},
+		{`{"a":1.0}`, `{"a":1}`, EqualityOptionsStruct{CompareNumbersByValue: true}, ""},
+		{`{"a":[1e2,0.5]}`, `{"a":[100,5E-1]}`, EqualityOptionsStruct{CompareNumbersByValue: true}, ""},
+		{`{"a":{"b/c":"x"}}`, `{"a":{"b/c":"y"}}`, EqualityOptionsStruct{}, "/a/b~1c"},
+		{`{"a":1}`, `{"a":1,"b":2}`, EqualityOptionsStruct{}, "/b"},
+		{`{"a":"1"}`, `{"a":1}`, EqualityOptionsStruct{}, "/a"},
+		{`{"a":[1,2,3]}`, `{"a":[1,2]}`, EqualityOptionsStruct{}, "/a/2"},
+		{`{"a":[1,2,2]}`, `{"a":[2,1,2]}`, EqualityOptionsStruct{}, "/a/0"},
+		{`{"a":[1,2,2]}`, `{"a":[2,1,2]}`, EqualityOptionsStruct{ArraysAsMultisets: true}, ""},
+		{`{"a":[1,2,2]}`, `{"a":[2,1,1]}`, EqualityOptionsStruct{ArraysAsMultisets: true}, "/a/2"},
+	}
+	for _, c := range testCases {
+		a, err := ParseObject(c.a)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.a, err)
+		}
+		b, err := ParseObject(c.b)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.b, err)
+		}
+		path, different := FindFirstDifference(NewJSONObjectValue(a), NewJSONObjectValue(b), c.options)
+		if different != (c.expected != "") || path != c.expected {
+			t.Errorf("unexpected difference between %s and %s: %q", c.a, c.b, path)
+		}
+		if Equal(NewJSONObjectValue(a), NewJSONObjectValue(b), c.options) != (c.expected == "") {
+			t.Errorf("unexpected equality between %s and %s", c.a, c.b)
+		}
+	}
+}
+
+type differenceTestCaseStruct struct {
+	a        string
+	b        string
+	options  EqualityOptionsStruct
+	expected string
+}
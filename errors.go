@@ -0,0 +1,61 @@
+package json
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Returned when an object member or array element doesn't exist.
+var ErrNotFound = errors.New("not found")
+
+// Returned when a value exists but isn't of the requested type.
+// The returned error is a [*TypeMismatchErrorStruct].
+var ErrTypeMismatch = errors.New("type mismatch")
+
+// Returned when a JSON number cannot be represented by the requested type,
+// and by Shape.Validate() for values outside the bounds of a rule.
+var ErrOutOfRange = errors.New("out of range")
+
+// Returned when a negative JSON number is read as an unsigned integer.
+// Matches [ErrOutOfRange].
+var ErrNegativeNumber = fmt.Errorf("negative number %w", ErrOutOfRange)
+
+// Returned when a JSON number with a fractional part is read as an integer.
+var ErrFractionalNumber = errors.New("fractional number")
+
+// Returned or used as the panic value when a frozen object or array is modified.
+var ErrFrozen = errors.New("frozen")
+
+// Returned by [Unmarshal] when an object has a member without a matching struct field
+// and unknown fields are rejected, and by Shape.Validate() for members not in a strict object shape.
+var ErrUnknownField = errors.New("unknown field")
+
+// Matches [ErrTypeMismatch].
+type TypeMismatchErrorStruct struct {
+	Expected Kind
+	Actual   Kind
+}
+
+func (err *TypeMismatchErrorStruct) Error() string {
+	return fmt.Sprintf("expected %s, got %s", err.Expected.String(), err.Actual.String())
+}
+
+func (err *TypeMismatchErrorStruct) Is(target error) bool {
+	return target == ErrTypeMismatch
+}
+
+func (object *ObjectStruct) memberError(key string, expected Kind) error {
+	value, ok := object.get(key)
+	if !ok {
+		return fmt.Errorf("member %s %w", key, ErrNotFound)
+	}
+	return fmt.Errorf("member %s: %w", key, &TypeMismatchErrorStruct{Expected: expected, Actual: value.kind})
+}
+
+func (array *ArrayStruct) elementError(index int, expected Kind) error {
+	value, ok := array.get(index)
+	if !ok {
+		return fmt.Errorf("element %d %w", index, ErrNotFound)
+	}
+	return fmt.Errorf("element %d: %w", index, &TypeMismatchErrorStruct{Expected: expected, Actual: value.kind})
+}
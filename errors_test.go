@@ -0,0 +1,92 @@
+package json
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetterErrors(t *testing.T) {
+	object, err := ParseObject(`{"name":"pilcrow","age":1.5,"count":300,"tags":[1],"id":1.0}`)
+	if err != nil {
+		t.Fatalf("failed to parse object: %s", err)
+	}
+
+	_, err = object.GetString("email")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+
+	_, err = object.GetString("age")
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch: %v", err)
+	}
+	var typeMismatchError *TypeMismatchErrorStruct
+	if !errors.As(err, &typeMismatchError) {
+		t.Fatalf("expected TypeMismatchErrorStruct: %v", err)
+	}
+	if typeMismatchError.Expected != KindString || typeMismatchError.Actual != KindNumber {
+		t.Errorf("unexpected kinds: %s", typeMismatchError)
+	}
+
+	_, err = object.GetInt("name")
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch: %v", err)
+	}
+	_, err = object.GetInt("age")
+	if !errors.Is(err, ErrFractionalNumber) {
+		t.Errorf("expected ErrFractionalNumber: %v", err)
+	}
+	large, err := ParseObject(`{"a":1e9223372036854775807}`)
+	if err != nil {
+		t.Fatalf("failed to parse object: %s", err)
+	}
+	_, err = object.GetInt64("id")
+	if err == nil {
+		t.Error("expected error on integer with a fractional part")
+	}
+	_, err = object.GetUint64("id")
+	if err == nil {
+		t.Error("expected error on integer with a fractional part")
+	}
+	_, err = large.GetInt64("a")
+	if err == nil {
+		t.Error("expected error on huge exponent")
+	}
+	_, err = large.GetUint64("a")
+	if err == nil {
+		t.Error("expected error on huge exponent")
+	}
+	_, err = object.GetUint8("count")
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected ErrOutOfRange: %v", err)
+	}
+
+	tags, err := object.GetJSONArray("tags")
+	if err != nil {
+		t.Fatalf("failed to get tags: %s", err)
+	}
+	_, err = tags.GetBool(0)
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch: %v", err)
+	}
+	_, err = tags.GetBool(1)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+	_, err = tags.IsNull(0)
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch: %v", err)
+	}
+	_, err = tags.IsNull(1)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+	_, err = object.IsNull("name")
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch: %v", err)
+	}
+	_, err = object.IsNull("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package json
+
+import (
+	"maps"
+	"slices"
+)
+
+// Makes the object and all nested objects and arrays read-only.
+// Any method that modifies a frozen object panics with [ErrFrozen],
+// except ObjectStruct.SetAt() and ObjectStruct.DeleteAt(), which return it.
+// Objects and arrays returned by the getters of a frozen object are frozen as well.
+//
+// A frozen object is safe for concurrent reads.
+// Copies of the object made before Freeze() was called are not frozen
+// and must not be modified after the object is shared.
+func (object *ObjectStruct) Freeze() {
+	if object.frozen {
+		return
+	}
+	if object.copyOnWrite {
+		// The maps are shared with a frozen object.
+		object.copyOnWrite = false
+		object.frozen = true
+		return
+	}
+	for _, value := range object.objects {
+		value.Freeze()
+	}
+	for _, value := range object.arrays {
+		value.Freeze()
+	}
+	object.frozen = true
+}
+
+// Returns true if the object is frozen.
+func (object *ObjectStruct) IsFrozen() bool {
+	return object.frozen
+}
+
+// Returns a modifiable copy of the object.
+// The copy shares its contents with the frozen object until it is first modified,
+// and nested objects and arrays are only copied once they are modified.
+// If the object isn't frozen, the copy is a deep copy made with ObjectStruct.Clone().
+func (object *ObjectStruct) Thaw() ObjectStruct {
+	if !object.frozen {
+		return object.Clone()
+	}
+	thawed := *object
+	thawed.frozen = false
+	thawed.copyOnWrite = true
+	return thawed
+}
+
+func (object *ObjectStruct) prepareWrite() {
+	if object.frozen {
+		panic(ErrFrozen)
+	}
+	if !object.copyOnWrite {
+		return
+	}
+	object.strings = maps.Clone(object.strings)
+	object.numbers = maps.Clone(object.numbers)
+	object.bools = maps.Clone(object.bools)
+	object.nulls = maps.Clone(object.nulls)
+	object.objects = maps.Clone(object.objects)
+	object.arrays = maps.Clone(object.arrays)
+	object.Keys = slices.Clone(object.Keys)
+	object.copyOnWrite = false
+}
+
+// Frozen values read from a modifiable object are returned as copy-on-write copies.
+func (object *ObjectStruct) readObject(value *ObjectStruct) ObjectStruct {
+	if value.frozen && !object.frozen {
+		return value.Thaw()
+	}
+	return *value
+}
+
+func (object *ObjectStruct) readArray(value *ArrayStruct) ArrayStruct {
+	if value.frozen && !object.frozen {
+		return value.Thaw()
+	}
+	return *value
+}
+
+// Makes the array and all nested objects and arrays read-only.
+// Any method that modifies a frozen array panics with [ErrFrozen],
+// except ArrayStruct.SetAt() and ArrayStruct.DeleteAt(), which return it.
+// Objects and arrays returned by the getters of a frozen array are frozen as well.
+//
+// A frozen array is safe for concurrent reads.
+// Copies of the array made before Freeze() was called are not frozen
+// and must not be modified after the array is shared.
+func (array *ArrayStruct) Freeze() {
+	if array.frozen {
+		return
+	}
+	if array.copyOnWrite {
+		// The maps are shared with a frozen array.
+		array.copyOnWrite = false
+		array.frozen = true
+		return
+	}
+	for _, value := range array.objects {
+		value.Freeze()
+	}
+	for _, value := range array.arrays {
+		value.Freeze()
+	}
+	array.frozen = true
+}
+
+// Returns true if the array is frozen.
+func (array *ArrayStruct) IsFrozen() bool {
+	return array.frozen
+}
+
+// Returns a modifiable copy of the array.
+// The copy shares its contents with the frozen array until it is first modified,
+// and nested objects and arrays are only copied once they are modified.
+// If the array isn't frozen, the copy is a deep copy made with ArrayStruct.Clone().
+func (array *ArrayStruct) Thaw() ArrayStruct {
+	if !array.frozen {
+		return array.Clone()
+	}
+	thawed := *array
+	thawed.frozen = false
+	thawed.copyOnWrite = true
+	return thawed
+}
+
+func (array *ArrayStruct) prepareWrite() {
+	if array.frozen {
+		panic(ErrFrozen)
+	}
+	if !array.copyOnWrite {
+		return
+	}
+	array.strings = maps.Clone(array.strings)
+	array.numbers = maps.Clone(array.numbers)
+	array.bools = maps.Clone(array.bools)
+	array.nulls = maps.Clone(array.nulls)
+	array.objects = maps.Clone(array.objects)
+	array.arrays = maps.Clone(array.arrays)
+	array.copyOnWrite = false
+}
+
+// Frozen values read from a modifiable array are returned as copy-on-write copies.
+func (array *ArrayStruct) readObject(value *ObjectStruct) ObjectStruct {
+	if value.frozen && !array.frozen {
+		return value.Thaw()
+	}
+	return *value
+}
+
+func (array *ArrayStruct) readArray(value *ArrayStruct) ArrayStruct {
+	if value.frozen && !array.frozen {
+		return value.Thaw()
+	}
+	return *value
+}
@@ -0,0 +1,86 @@
+package json
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestFreeze(t *testing.T) {
+	object, err := ParseObject(`{"a":1,"b":{"c":[1,{"d":2}]}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	object.Freeze()
+
+	expectFrozenPanic(t, func() { object.SetString("a", "x") })
+	expectFrozenPanic(t, func() { object.Delete("a") })
+	nested, _ := object.GetJSONObject("b")
+	expectFrozenPanic(t, func() { nested.SetString("c", "x") })
+	array, _ := nested.GetJSONArray("c")
+	expectFrozenPanic(t, func() { array.AddNull() })
+	element, _ := array.GetJSONObject(1)
+	expectFrozenPanic(t, func() { element.SetBool("d", true) })
+	if err := object.SetAt("/b/c/0", NewBoolValue(true), false); !errors.Is(err, ErrFrozen) {
+		t.Errorf("expected ErrFrozen, got %v", err)
+	}
+	if err := object.DeleteAt("/a"); !errors.Is(err, ErrFrozen) {
+		t.Errorf("expected ErrFrozen, got %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			object.String(MinimalStringCharacterEscapingBehavior)
+			object.GetAt("/b/c/1/d")
+			thawed := object.Thaw()
+			thawed.SetString("e", "f")
+		}()
+	}
+	wg.Wait()
+}
+
+func TestThaw(t *testing.T) {
+	object, err := ParseObject(`{"a":1,"b":{"c":[1,{"d":2}]}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	object.Freeze()
+
+	thawed := object.Thaw()
+	if thawed.IsFrozen() {
+		t.Fatal("thawed object is frozen")
+	}
+	thawed.SetString("e", "f")
+	err = thawed.SetAt("/b/c/1/d", NewNumberValue("3"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested, _ := thawed.GetJSONObject("b")
+	array, _ := nested.GetJSONArray("c")
+	array.AddBool(true)
+
+	if got := thawed.String(MinimalStringCharacterEscapingBehavior); got != `{"a":1,"b":{"c":[1,{"d":3}]},"e":"f"}` {
+		t.Errorf("unexpected thawed object: %s", got)
+	}
+	if got := object.String(MinimalStringCharacterEscapingBehavior); got != `{"a":1,"b":{"c":[1,{"d":2}]}}` {
+		t.Errorf("frozen object modified: %s", got)
+	}
+
+	thawed.Freeze()
+	expectFrozenPanic(t, func() { thawed.SetNull("a") })
+}
+
+func expectFrozenPanic(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		recovered := recover()
+		err, ok := recovered.(error)
+		if !ok || !errors.Is(err, ErrFrozen) {
+			t.Errorf("expected ErrFrozen panic, got %v", recovered)
+		}
+	}()
+	f()
+}
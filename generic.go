@@ -0,0 +1,169 @@
+package json
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// The Go types supported by [Get], [GetAt], and [Set].
+// Go strings are JSON strings.
+type ValueTypeInterface interface {
+	string | bool | int | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64 | ObjectStruct | ArrayStruct | ValueStruct
+}
+
+// Returns a member as T using the typed getter of the object (e.g. ObjectStruct.GetInt64() for int64).
+// Returns the same errors as the typed getter.
+func Get[T ValueTypeInterface](object *ObjectStruct, key string) (T, error) {
+	var result T
+	var err error
+	switch p := any(&result).(type) {
+	case *string:
+		*p, err = object.GetString(key)
+	case *bool:
+		*p, err = object.GetBool(key)
+	case *int:
+		*p, err = object.GetInt(key)
+	case *int32:
+		*p, err = object.GetInt32(key)
+	case *int64:
+		*p, err = object.GetInt64(key)
+	case *uint:
+		*p, err = object.GetUint(key)
+	case *uint8:
+		*p, err = object.GetUint8(key)
+	case *uint16:
+		*p, err = object.GetUint16(key)
+	case *uint32:
+		*p, err = object.GetUint32(key)
+	case *uint64:
+		*p, err = object.GetUint64(key)
+	case *float32:
+		*p, err = object.GetFloat32(key)
+	case *float64:
+		*p, err = object.GetFloat64(key)
+	case *ObjectStruct:
+		*p, err = object.GetJSONObject(key)
+	case *ArrayStruct:
+		*p, err = object.GetJSONArray(key)
+	case *ValueStruct:
+		*p, err = object.Get(key)
+	}
+	return result, err
+}
+
+// Returns the value at the location referenced by the JSON Pointer (RFC 6901) as T
+// using the typed getters of the object (e.g. ObjectStruct.GetInt64At() for int64).
+// Returns the same errors as the typed getter.
+func GetAt[T ValueTypeInterface](object *ObjectStruct, pointer string) (T, error) {
+	var result T
+	var err error
+	switch p := any(&result).(type) {
+	case *string:
+		*p, err = object.GetStringAt(pointer)
+	case *bool:
+		*p, err = object.GetBoolAt(pointer)
+	case *int:
+		var parsed int64
+		parsed, err = getIntegerAt(object, pointer, "int", strconv.IntSize, parseSignedInteger)
+		*p = int(parsed)
+	case *int32:
+		var parsed int64
+		parsed, err = getIntegerAt(object, pointer, "int32", 32, parseSignedInteger)
+		*p = int32(parsed)
+	case *int64:
+		*p, err = object.GetInt64At(pointer)
+	case *uint:
+		var parsed uint64
+		parsed, err = getIntegerAt(object, pointer, "uint", strconv.IntSize, parseUnsignedInteger)
+		*p = uint(parsed)
+	case *uint8:
+		var parsed uint64
+		parsed, err = getIntegerAt(object, pointer, "uint8", 8, parseUnsignedInteger)
+		*p = uint8(parsed)
+	case *uint16:
+		var parsed uint64
+		parsed, err = getIntegerAt(object, pointer, "uint16", 16, parseUnsignedInteger)
+		*p = uint16(parsed)
+	case *uint32:
+		var parsed uint64
+		parsed, err = getIntegerAt(object, pointer, "uint32", 32, parseUnsignedInteger)
+		*p = uint32(parsed)
+	case *uint64:
+		*p, err = getIntegerAt(object, pointer, "uint64", 64, parseUnsignedInteger)
+	case *float32:
+		var value string
+		value, err = object.GetNumberAt(pointer)
+		if err == nil {
+			var parsed float64
+			parsed, err = parseFloat(value, 32)
+			if err != nil {
+				err = fmt.Errorf("failed to parse float32: %w", err)
+			}
+			*p = float32(parsed)
+		}
+	case *float64:
+		*p, err = object.GetFloat64At(pointer)
+	case *ObjectStruct:
+		*p, err = object.GetJSONObjectAt(pointer)
+	case *ArrayStruct:
+		*p, err = object.GetJSONArrayAt(pointer)
+	case *ValueStruct:
+		*p, err = object.GetAt(pointer)
+	}
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
+
+func getIntegerAt[T int64 | uint64](object *ObjectStruct, pointer string, name string, bitSize int, parse func(lexeme string, bitSize int) (T, error)) (T, error) {
+	value, err := object.GetNumberAt(pointer)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := parse(value, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return parsed, nil
+}
+
+// Sets a member using the typed setter of the object (e.g. ObjectStruct.SetInt64() for int64).
+// Overrides any member with the same name.
+// Returns an error if the value is a NaN or infinite float.
+func Set[T ValueTypeInterface](object *ObjectStruct, key string, value T) error {
+	switch v := any(value).(type) {
+	case string:
+		object.SetString(key, v)
+	case bool:
+		object.SetBool(key, v)
+	case int:
+		object.SetInt(key, v)
+	case int32:
+		object.SetInt32(key, v)
+	case int64:
+		object.SetInt64(key, v)
+	case uint:
+		object.SetUint(key, v)
+	case uint8:
+		object.SetUint8(key, v)
+	case uint16:
+		object.SetUint16(key, v)
+	case uint32:
+		object.SetUint32(key, v)
+	case uint64:
+		object.SetUint64(key, v)
+	case float32:
+		return object.SetFloat32(key, v)
+	case float64:
+		return object.SetFloat64(key, v)
+	case ObjectStruct:
+		object.SetJSONObject(key, v)
+	case ArrayStruct:
+		object.SetJSONArray(key, v)
+	case ValueStruct:
+		object.Set(key, v)
+	}
+	return nil
+}
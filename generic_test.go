@@ -0,0 +1,47 @@
+package json
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGenericAccessors(t *testing.T) {
+	object, err := ParseObject(`{"a":{"b":"c","d":300,"e":[true]},"f":-1}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, err := GetAt[string](&object, "/a/b"); err != nil || s != "c" {
+		t.Errorf("unexpected result: %v %v", s, err)
+	}
+	if n, err := GetAt[uint16](&object, "/a/d"); err != nil || n != 300 {
+		t.Errorf("unexpected result: %v %v", n, err)
+	}
+	if _, err := GetAt[uint8](&object, "/a/d"); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected ErrOutOfRange, got %v", err)
+	}
+	if b, err := GetAt[bool](&object, "/a/e/0"); err != nil || !b {
+		t.Errorf("unexpected result: %v %v", b, err)
+	}
+	if n, err := Get[int64](&object, "f"); err != nil || n != -1 {
+		t.Errorf("unexpected result: %v %v", n, err)
+	}
+	if _, err := Get[uint](&object, "f"); !errors.Is(err, ErrNegativeNumber) {
+		t.Errorf("expected ErrNegativeNumber, got %v", err)
+	}
+	if _, err := Get[string](&object, "f"); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch, got %v", err)
+	}
+	if _, err := Get[ArrayStruct](&object, "g"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	result := NewObject()
+	Set(&result, "a", "b")
+	Set(&result, "c", uint8(1))
+	Set(&result, "d", 0.5)
+	Set(&result, "e", ArrayFromStrings([]string{"f"}))
+	Set(&result, "g", NewNullValue())
+	if got := result.String(MinimalStringCharacterEscapingBehavior); got != `{"a":"b","c":1,"d":0.5,"e":["f"],"g":null}` {
+		t.Errorf("unexpected output: %s", got)
+	}
+}
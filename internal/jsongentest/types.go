@@ -0,0 +1,40 @@
+// Package jsongentest contains types with code generated by cmd/jsongen.
+package jsongentest
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/pilcrowonpaper/go-json"
+)
+
+//go:generate go run ../../cmd/jsongen -type UserStruct,AddressStruct -output types_jsongen.go
+
+type RoleString string
+
+type UserStruct struct {
+	ID        int64             `json:"id"`
+	Name      string            `json:"name"`
+	Email     string            `json:"email,omitempty"`
+	Role      RoleString        `json:"role"`
+	Admin     bool              `json:"admin,omitempty"`
+	Score     float64           `json:"score"`
+	Balance   *big.Rat          `json:"balance"`
+	Avatar    []byte            `json:"avatar,omitempty"`
+	Address   AddressStruct     `json:"address"`
+	Previous  []AddressStruct   `json:"previous_addresses,omitempty"`
+	Manager   *UserStruct       `json:"manager,omitempty"`
+	Nickname  *string           `json:"nickname"`
+	Tags      []string          `json:"tags"`
+	Counts    []int32           `json:"counts,omitempty"`
+	Created   time.Time         `json:"created"`
+	Timeout   time.Duration     `json:"timeout,omitempty"`
+	Metadata  json.ObjectStruct `json:"metadata,omitempty"`
+	Password  string            `json:"-"`
+	sessionID string
+}
+
+type AddressStruct struct {
+	City    string `json:"city"`
+	Country string `json:"country"`
+}
@@ -0,0 +1,286 @@
+// Code generated by jsongen. DO NOT EDIT.
+
+package jsongentest
+
+import (
+	"fmt"
+
+	"github.com/pilcrowonpaper/go-json"
+)
+
+// Encodes the struct to a JSON object.
+// Panics if a number cannot be encoded (e.g. a NaN float).
+func (userStruct UserStruct) EncodeJSON(stringCharacterEscapingBehavior json.StringCharacterEscapingBehaviorInterface) string {
+	objectBuilder := json.NewObjectBuilder(stringCharacterEscapingBehavior)
+	objectBuilder.AddInt64("id", userStruct.ID)
+	objectBuilder.AddString("name", userStruct.Name)
+	if userStruct.Email != "" {
+		objectBuilder.AddString("email", userStruct.Email)
+	}
+	objectBuilder.AddString("role", string(userStruct.Role))
+	if userStruct.Admin {
+		objectBuilder.AddBool("admin", userStruct.Admin)
+	}
+	if err := objectBuilder.AddFloat64("score", userStruct.Score); err != nil {
+		panic(fmt.Errorf("failed to encode member score: %w", err))
+	}
+	if userStruct.Balance == nil {
+		objectBuilder.AddNull("balance")
+	} else {
+		if err := objectBuilder.AddRat("balance", userStruct.Balance); err != nil {
+			panic(fmt.Errorf("failed to encode member balance: %w", err))
+		}
+	}
+	if len(userStruct.Avatar) != 0 {
+		objectBuilder.AddBytes("avatar", userStruct.Avatar, json.BytesEncodingBase64)
+	}
+	objectBuilder.AddJSON("address", userStruct.Address.EncodeJSON(stringCharacterEscapingBehavior))
+	if len(userStruct.Previous) != 0 {
+		arrayBuilder := json.NewArrayBuilder(stringCharacterEscapingBehavior)
+		for _, element := range userStruct.Previous {
+			arrayBuilder.AddJSON(element.EncodeJSON(stringCharacterEscapingBehavior))
+		}
+		objectBuilder.AddJSON("previous_addresses", arrayBuilder.Done())
+	}
+	if userStruct.Manager != nil {
+		objectBuilder.AddJSON("manager", userStruct.Manager.EncodeJSON(stringCharacterEscapingBehavior))
+	}
+	if userStruct.Nickname == nil {
+		objectBuilder.AddNull("nickname")
+	} else {
+		objectBuilder.AddString("nickname", *userStruct.Nickname)
+	}
+	if userStruct.Tags == nil {
+		objectBuilder.AddNull("tags")
+	} else {
+		arrayBuilder := json.NewArrayBuilder(stringCharacterEscapingBehavior)
+		for _, element := range userStruct.Tags {
+			arrayBuilder.AddString(element)
+		}
+		objectBuilder.AddJSON("tags", arrayBuilder.Done())
+	}
+	if len(userStruct.Counts) != 0 {
+		arrayBuilder := json.NewArrayBuilder(stringCharacterEscapingBehavior)
+		for _, element := range userStruct.Counts {
+			arrayBuilder.AddInt64(int64(element))
+		}
+		objectBuilder.AddJSON("counts", arrayBuilder.Done())
+	}
+	objectBuilder.AddTime("created", userStruct.Created)
+	if userStruct.Timeout != 0 {
+		objectBuilder.AddDuration("timeout", userStruct.Timeout, json.DurationFormatString)
+	}
+	objectBuilder.AddJSON("metadata", userStruct.Metadata.String(stringCharacterEscapingBehavior))
+	return objectBuilder.Done()
+}
+
+// Decodes a JSON object into the struct.
+// Members of fields without the omitempty option are required.
+// Returns an error wrapping json.ErrNotFound if a required member is missing,
+// or an error if a member has an invalid value.
+func (userStruct *UserStruct) DecodeJSON(object json.ObjectStruct) error {
+	{
+		value, err := object.GetInt64("id")
+		if err != nil {
+			return err
+		}
+		userStruct.ID = value
+	}
+	{
+		value, err := object.GetString("name")
+		if err != nil {
+			return err
+		}
+		userStruct.Name = value
+	}
+	if object.Has("email") {
+		value, err := object.GetString("email")
+		if err != nil {
+			return err
+		}
+		userStruct.Email = value
+	}
+	{
+		value, err := object.GetString("role")
+		if err != nil {
+			return err
+		}
+		userStruct.Role = RoleString(value)
+	}
+	if object.Has("admin") {
+		value, err := object.GetBool("admin")
+		if err != nil {
+			return err
+		}
+		userStruct.Admin = value
+	}
+	{
+		value, err := object.GetFloat64("score")
+		if err != nil {
+			return err
+		}
+		userStruct.Score = value
+	}
+	if object.ExistsAndIsNull("balance") {
+		userStruct.Balance = nil
+	} else {
+		value, err := object.GetRat("balance")
+		if err != nil {
+			return err
+		}
+		userStruct.Balance = value
+	}
+	if object.Has("avatar") {
+		if object.ExistsAndIsNull("avatar") {
+			userStruct.Avatar = nil
+		} else {
+			value, err := object.GetBytes("avatar", json.BytesEncodingBase64)
+			if err != nil {
+				return err
+			}
+			userStruct.Avatar = value
+		}
+	}
+	{
+		value, err := object.GetJSONObject("address")
+		if err != nil {
+			return err
+		}
+		err = userStruct.Address.DecodeJSON(value)
+		if err != nil {
+			return fmt.Errorf("member address: %w", err)
+		}
+	}
+	if object.Has("previous_addresses") {
+		if object.ExistsAndIsNull("previous_addresses") {
+			userStruct.Previous = nil
+		} else {
+			array, err := object.GetJSONArray("previous_addresses")
+			if err != nil {
+				return err
+			}
+			userStruct.Previous = make([]AddressStruct, array.Length)
+			for i := range array.Length {
+				value, err := array.GetJSONObject(i)
+				if err != nil {
+					return fmt.Errorf("member previous_addresses: %w", err)
+				}
+				err = userStruct.Previous[i].DecodeJSON(value)
+				if err != nil {
+					return fmt.Errorf("member previous_addresses: element %d: %w", i, err)
+				}
+			}
+		}
+	}
+	if object.Has("manager") {
+		if object.ExistsAndIsNull("manager") {
+			userStruct.Manager = nil
+		} else {
+			userStruct.Manager = new(UserStruct)
+			value, err := object.GetJSONObject("manager")
+			if err != nil {
+				return err
+			}
+			err = userStruct.Manager.DecodeJSON(value)
+			if err != nil {
+				return fmt.Errorf("member manager: %w", err)
+			}
+		}
+	}
+	if object.ExistsAndIsNull("nickname") {
+		userStruct.Nickname = nil
+	} else {
+		userStruct.Nickname = new(string)
+		value, err := object.GetString("nickname")
+		if err != nil {
+			return err
+		}
+		*userStruct.Nickname = value
+	}
+	if object.ExistsAndIsNull("tags") {
+		userStruct.Tags = nil
+	} else {
+		array, err := object.GetJSONArray("tags")
+		if err != nil {
+			return err
+		}
+		userStruct.Tags = make([]string, array.Length)
+		for i := range array.Length {
+			value, err := array.GetString(i)
+			if err != nil {
+				return fmt.Errorf("member tags: %w", err)
+			}
+			userStruct.Tags[i] = value
+		}
+	}
+	if object.Has("counts") {
+		if object.ExistsAndIsNull("counts") {
+			userStruct.Counts = nil
+		} else {
+			array, err := object.GetJSONArray("counts")
+			if err != nil {
+				return err
+			}
+			userStruct.Counts = make([]int32, array.Length)
+			for i := range array.Length {
+				value, err := array.GetInt32(i)
+				if err != nil {
+					return fmt.Errorf("member counts: %w", err)
+				}
+				userStruct.Counts[i] = value
+			}
+		}
+	}
+	{
+		value, err := object.GetTime("created")
+		if err != nil {
+			return err
+		}
+		userStruct.Created = value
+	}
+	if object.Has("timeout") {
+		value, err := object.GetDuration("timeout", json.DurationFormatString)
+		if err != nil {
+			return err
+		}
+		userStruct.Timeout = value
+	}
+	if object.Has("metadata") {
+		value, err := object.GetJSONObject("metadata")
+		if err != nil {
+			return err
+		}
+		userStruct.Metadata = value
+	}
+	return nil
+}
+
+// Encodes the struct to a JSON object.
+func (addressStruct AddressStruct) EncodeJSON(stringCharacterEscapingBehavior json.StringCharacterEscapingBehaviorInterface) string {
+	objectBuilder := json.NewObjectBuilder(stringCharacterEscapingBehavior)
+	objectBuilder.AddString("city", addressStruct.City)
+	objectBuilder.AddString("country", addressStruct.Country)
+	return objectBuilder.Done()
+}
+
+// Decodes a JSON object into the struct.
+// Members of fields without the omitempty option are required.
+// Returns an error wrapping json.ErrNotFound if a required member is missing,
+// or an error if a member has an invalid value.
+func (addressStruct *AddressStruct) DecodeJSON(object json.ObjectStruct) error {
+	{
+		value, err := object.GetString("city")
+		if err != nil {
+			return err
+		}
+		addressStruct.City = value
+	}
+	{
+		value, err := object.GetString("country")
+		if err != nil {
+			return err
+		}
+		addressStruct.Country = value
+	}
+	return nil
+}
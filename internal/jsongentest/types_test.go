@@ -0,0 +1,80 @@
+package jsongentest
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/pilcrowonpaper/go-json"
+)
+
+func TestUserStruct(t *testing.T) {
+	nickname := "al"
+	metadata := json.NewObject()
+	metadata.SetBool("beta", true)
+	user := UserStruct{
+		ID:       1,
+		Name:     "Alice",
+		Role:     "admin",
+		Score:    0.5,
+		Balance:  big.NewRat(5, 4),
+		Address:  AddressStruct{City: "Tokyo", Country: "JP"},
+		Previous: []AddressStruct{{City: "Osaka", Country: "JP"}},
+		Manager:  &UserStruct{ID: 2, Name: "Bob", Created: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Nickname: &nickname,
+		Tags:     []string{"a", "b"},
+		Counts:   []int32{-1},
+		Created:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Timeout:  time.Minute,
+		Metadata: metadata,
+		Password: "secret",
+	}
+	encoded := user.EncodeJSON(json.MinimalStringCharacterEscapingBehavior)
+	expected := `{"id":1,"name":"Alice","role":"admin","score":0.5,"balance":1.25,"address":{"city":"Tokyo","country":"JP"},"previous_addresses":[{"city":"Osaka","country":"JP"}],"manager":{"id":2,"name":"Bob","role":"","score":0,"balance":null,"address":{"city":"","country":""},"nickname":null,"tags":null,"created":"2020-01-01T00:00:00Z","metadata":{}},"nickname":"al","tags":["a","b"],"counts":[-1],"created":"2024-01-02T03:04:05Z","timeout":"1m0s","metadata":{"beta":true}}`
+	if encoded != expected {
+		t.Errorf("unexpected output: %s", encoded)
+	}
+
+	object, err := json.ParseObject(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded UserStruct
+	err = decoded.DecodeJSON(object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reencoded := decoded.EncodeJSON(json.MinimalStringCharacterEscapingBehavior); reencoded != expected {
+		t.Errorf("unexpected output after round trip: %s", reencoded)
+	}
+	if decoded.Password != "" {
+		t.Errorf("unexpected password: %s", decoded.Password)
+	}
+}
+
+func TestUserStructDecodeError(t *testing.T) {
+	failCases := []decodeErrorTestCaseStruct{
+		{`{"name":"Alice"}`, json.ErrNotFound},
+		{`{"id":1,"name":"Alice","role":"","score":0,"balance":null,"address":{"city":""},"nickname":null,"tags":null,"created":"2020-01-01T00:00:00Z"}`, json.ErrNotFound},
+		{`{"id":"1"}`, json.ErrTypeMismatch},
+		{`{"id":1.5}`, json.ErrFractionalNumber},
+		{`{"id":1,"name":"Alice","role":"","score":0,"balance":null,"address":{"city":"","country":""},"nickname":null,"tags":[1],"created":"2020-01-01T00:00:00Z"}`, json.ErrTypeMismatch},
+	}
+	for _, c := range failCases {
+		object, err := json.ParseObject(c.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded UserStruct
+		err = decoded.DecodeJSON(object)
+		if !errors.Is(err, c.expected) {
+			t.Errorf("unexpected error on input %s: %v", c.s, err)
+		}
+	}
+}
+
+type decodeErrorTestCaseStruct struct {
+	s        string
+	expected error
+}
@@ -0,0 +1,123 @@
+package json
+
+import (
+	"iter"
+)
+
+// Returns an iterator over the object members in order.
+func (object *ObjectStruct) All() iter.Seq2[string, ValueStruct] {
+	return func(yield func(string, ValueStruct) bool) {
+		for _, key := range object.Keys {
+			value, _ := object.get(key)
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the object members in order.
+// Iteration stops at the first member whose value isn't a JSON string.
+func (object *ObjectStruct) Strings() iter.Seq2[string, string] {
+	return objectMembers(object, object.strings)
+}
+
+// Returns an iterator over the object members in order.
+// Iteration stops at the first member whose value isn't a JSON number.
+func (object *ObjectStruct) Numbers() iter.Seq2[string, string] {
+	return objectMembers(object, object.numbers)
+}
+
+// Returns an iterator over the object members in order.
+// Iteration stops at the first member whose value isn't a JSON boolean.
+func (object *ObjectStruct) Bools() iter.Seq2[string, bool] {
+	return objectMembers(object, object.bools)
+}
+
+// Returns an iterator over the object members in order.
+// Iteration stops at the first member whose value isn't a JSON object.
+func (object *ObjectStruct) JSONObjects() iter.Seq2[string, ObjectStruct] {
+	return mapIteratorValues(objectMembers(object, object.objects), object.readObject)
+}
+
+// Returns an iterator over the object members in order.
+// Iteration stops at the first member whose value isn't a JSON array.
+func (object *ObjectStruct) JSONArrays() iter.Seq2[string, ArrayStruct] {
+	return mapIteratorValues(objectMembers(object, object.arrays), object.readArray)
+}
+
+// The typed iterators don't return errors so they can be used directly in range statements.
+// Use the getters (e.g. [ObjectStruct.GetString]) to get an error on type mismatch.
+func objectMembers[T any](object *ObjectStruct, values map[string]T) iter.Seq2[string, T] {
+	return func(yield func(string, T) bool) {
+		for _, key := range object.Keys {
+			value, ok := values[key]
+			if !ok || !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the array elements in order.
+func (array *ArrayStruct) All() iter.Seq2[int, ValueStruct] {
+	return func(yield func(int, ValueStruct) bool) {
+		for i := range array.Length {
+			value, _ := array.get(i)
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the array elements in order.
+// Iteration stops at the first element that isn't a JSON string.
+func (array *ArrayStruct) Strings() iter.Seq2[int, string] {
+	return arrayElements(array, array.strings)
+}
+
+// Returns an iterator over the array elements in order.
+// Iteration stops at the first element that isn't a JSON number.
+func (array *ArrayStruct) Numbers() iter.Seq2[int, string] {
+	return arrayElements(array, array.numbers)
+}
+
+// Returns an iterator over the array elements in order.
+// Iteration stops at the first element that isn't a JSON boolean.
+func (array *ArrayStruct) Bools() iter.Seq2[int, bool] {
+	return arrayElements(array, array.bools)
+}
+
+// Returns an iterator over the array elements in order.
+// Iteration stops at the first element that isn't a JSON object.
+func (array *ArrayStruct) JSONObjects() iter.Seq2[int, ObjectStruct] {
+	return mapIteratorValues(arrayElements(array, array.objects), array.readObject)
+}
+
+// Returns an iterator over the array elements in order.
+// Iteration stops at the first element that isn't a JSON array.
+func (array *ArrayStruct) JSONArrays() iter.Seq2[int, ArrayStruct] {
+	return mapIteratorValues(arrayElements(array, array.arrays), array.readArray)
+}
+
+func arrayElements[T any](array *ArrayStruct, values map[int]T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range array.Length {
+			value, ok := values[i]
+			if !ok || !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
+func mapIteratorValues[K any, V any, W any](seq iter.Seq2[K, V], f func(V) W) iter.Seq2[K, W] {
+	return func(yield func(K, W) bool) {
+		for key, value := range seq {
+			if !yield(key, f(value)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package json
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"testing"
+)
+
+func TestObjectIterators(t *testing.T) {
+	object, err := ParseObject(`{"b":1,"a":"x","c":null}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := formatIterator(object.All()); got != `b=1,a="x",c=null` {
+		t.Errorf("unexpected members: %s", got)
+	}
+	if n := countUntilBreak(object.All()); n != 1 {
+		t.Errorf("unexpected iterations after break: %d", n)
+	}
+
+	testCases := []iteratorTestCaseStruct{
+		{`{"b":"x","a":"y"}`, `b=x,a=y`},
+		{`{"b":1.50,"a":2e1}`, `b=1.50,a=2e1`},
+		{`{"b":true,"a":false}`, `b=true,a=false`},
+		{`{"b":{"c":1},"a":{}}`, `b={"c":1},a={}`},
+		{`{"b":[1],"a":[]}`, `b=[1],a=[]`},
+	}
+	// Iteration stops at the first mismatching member.
+	mismatchedCases := []iteratorTestCaseStruct{
+		{`{"b":"x","c":null,"a":"y"}`, `b=x`},
+		{`{"b":1.50,"c":null,"a":2e1}`, `b=1.50`},
+		{`{"b":true,"c":null,"a":false}`, `b=true`},
+		{`{"b":{"c":1},"c":null,"a":{}}`, `b={"c":1}`},
+		{`{"b":[1],"c":null,"a":[]}`, `b=[1]`},
+	}
+	iterators := []func(ObjectStruct) (string, int){
+		func(object ObjectStruct) (string, int) {
+			return formatTypedIterator(object.Strings())
+		},
+		func(object ObjectStruct) (string, int) {
+			return formatTypedIterator(object.Numbers())
+		},
+		func(object ObjectStruct) (string, int) {
+			return formatTypedIterator(object.Bools())
+		},
+		func(object ObjectStruct) (string, int) {
+			return formatTypedIterator(object.JSONObjects())
+		},
+		func(object ObjectStruct) (string, int) {
+			return formatTypedIterator(object.JSONArrays())
+		},
+	}
+	for i, c := range testCases {
+		object, err := ParseObject(c.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, n := iterators[i](object)
+		if got != c.expected {
+			t.Errorf("unexpected members on input %s: %s", c.s, got)
+		}
+		if n != 1 {
+			t.Errorf("unexpected iterations after break on input %s: %d", c.s, n)
+		}
+
+		mismatched, err := ParseObject(mismatchedCases[i].s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := iterators[i](mismatched); got != mismatchedCases[i].expected {
+			t.Errorf("unexpected members on input %s: %s", mismatchedCases[i].s, got)
+		}
+	}
+}
+
+func TestArrayIterators(t *testing.T) {
+	array, err := ParseArray(`[1,"x",null]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := formatIterator(array.All()); got != `0=1,1="x",2=null` {
+		t.Errorf("unexpected elements: %s", got)
+	}
+	if n := countUntilBreak(array.All()); n != 1 {
+		t.Errorf("unexpected iterations after break: %d", n)
+	}
+
+	testCases := []iteratorTestCaseStruct{
+		{`["x","y"]`, `0=x,1=y`},
+		{`[1.50,2e1]`, `0=1.50,1=2e1`},
+		{`[true,false]`, `0=true,1=false`},
+		{`[{"c":1},{}]`, `0={"c":1},1={}`},
+		{`[[1],[]]`, `0=[1],1=[]`},
+	}
+	// Iteration stops at the first mismatching element.
+	mismatchedCases := []iteratorTestCaseStruct{
+		{`["x",null,"y"]`, `0=x`},
+		{`[1.50,null,2e1]`, `0=1.50`},
+		{`[true,null,false]`, `0=true`},
+		{`[{"c":1},null,{}]`, `0={"c":1}`},
+		{`[[1],null,[]]`, `0=[1]`},
+	}
+	iterators := []func(ArrayStruct) (string, int){
+		func(array ArrayStruct) (string, int) {
+			return formatTypedIterator(array.Strings())
+		},
+		func(array ArrayStruct) (string, int) {
+			return formatTypedIterator(array.Numbers())
+		},
+		func(array ArrayStruct) (string, int) {
+			return formatTypedIterator(array.Bools())
+		},
+		func(array ArrayStruct) (string, int) {
+			return formatTypedIterator(array.JSONObjects())
+		},
+		func(array ArrayStruct) (string, int) {
+			return formatTypedIterator(array.JSONArrays())
+		},
+	}
+	for i, c := range testCases {
+		array, err := ParseArray(c.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, n := iterators[i](array)
+		if got != c.expected {
+			t.Errorf("unexpected elements on input %s: %s", c.s, got)
+		}
+		if n != 1 {
+			t.Errorf("unexpected iterations after break on input %s: %d", c.s, n)
+		}
+
+		mismatched, err := ParseArray(mismatchedCases[i].s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := iterators[i](mismatched); got != mismatchedCases[i].expected {
+			t.Errorf("unexpected elements on input %s: %s", mismatchedCases[i].s, got)
+		}
+	}
+}
+
+func formatTypedIterator[K any, V any](seq iter.Seq2[K, V]) (string, int) {
+	return formatIterator(seq), countUntilBreak(seq)
+}
+
+// Returns the members as "key=value" separated by commas.
+func formatIterator[K any, V any](seq iter.Seq2[K, V]) string {
+	members := []string{}
+	for key, value := range seq {
+		var formatted string
+		switch value := any(value).(type) {
+		case ValueStruct:
+			formatted = value.String(MinimalStringCharacterEscapingBehavior)
+		case ObjectStruct:
+			formatted = value.String(MinimalStringCharacterEscapingBehavior)
+		case ArrayStruct:
+			formatted = value.String(MinimalStringCharacterEscapingBehavior)
+		default:
+			formatted = fmt.Sprint(value)
+		}
+		members = append(members, fmt.Sprintf("%v=%s", key, formatted))
+	}
+	return strings.Join(members, ",")
+}
+
+// Breaks after the first member.
+// Iterators that ignore the result of yield panic.
+func countUntilBreak[K any, V any](seq iter.Seq2[K, V]) int {
+	n := 0
+	for range seq {
+		n++
+		break
+	}
+	return n
+}
+
+type iteratorTestCaseStruct struct {
+	s        string
+	expected string
+}
@@ -0,0 +1,418 @@
+package json
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// A compiled JSONPath (RFC 9535) query.
+// Queries are immutable and can be reused across documents and goroutines.
+// Use [CompileJSONPath].
+type JSONPathStruct struct {
+	query *jsonPathQueryStruct
+}
+
+// Compiles a JSONPath query (RFC 9535), such as $.sessions[?@.expires < 1700000000].id.
+// Supports child and descendant segments; name, wildcard, index, slice, and filter selectors;
+// and the length(), count(), match(), search(), and value() function extensions.
+// Returns an error if the query is invalid or not well-typed.
+//
+// Regular expressions in match() and search() are evaluated with Go's regexp package.
+func CompileJSONPath(query string) (*JSONPathStruct, error) {
+	parsed, err := parseJSONPathQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query: %s", err.Error())
+	}
+	return &JSONPathStruct{query: parsed}, nil
+}
+
+// A value selected by a JSONPath query.
+type JSONPathNodeStruct struct {
+	Value    ValueStruct
+	location *jsonPathLocationStruct
+}
+
+// Returns the normalized path of the node (e.g. $['sessions'][0]['id']).
+func (node *JSONPathNodeStruct) NormalizedPath() string {
+	return node.location.normalizedPath()
+}
+
+// Returns the location of the node as a JSON Pointer (e.g. /sessions/0/id).
+func (node *JSONPathNodeStruct) Pointer() string {
+	return node.location.pointer()
+}
+
+// Returns the nodes selected by the query in document order.
+// Objects are visited in the order of their keys.
+func (path *JSONPathStruct) Query(doc ValueStruct) []JSONPathNodeStruct {
+	nodes := path.query.evaluate(doc, doc)
+	result := make([]JSONPathNodeStruct, len(nodes))
+	for i, node := range nodes {
+		result[i] = JSONPathNodeStruct{Value: node.value, location: node.location}
+	}
+	return result
+}
+
+// Returns the values selected by the query in document order.
+func (path *JSONPathStruct) Values(doc ValueStruct) []ValueStruct {
+	nodes := path.query.evaluate(doc, doc)
+	result := make([]ValueStruct, len(nodes))
+	for i, node := range nodes {
+		result[i] = node.value
+	}
+	return result
+}
+
+// A location in a document.
+// Locations are shared between nodes to avoid copying paths.
+type jsonPathLocationStruct struct {
+	parent *jsonPathLocationStruct
+	name   string
+	index  int
+	// True if the location is an array element.
+	element bool
+}
+
+func (location *jsonPathLocationStruct) normalizedPath() string {
+	segments := []string{}
+	for current := location; current != nil; current = current.parent {
+		if current.element {
+			segments = append(segments, "["+strconv.Itoa(current.index)+"]")
+		} else {
+			segments = append(segments, "["+formatNormalizedPathName(current.name)+"]")
+		}
+	}
+	b := strings.Builder{}
+	b.WriteRune('$')
+	for i := len(segments) - 1; i >= 0; i-- {
+		b.WriteString(segments[i])
+	}
+	return b.String()
+}
+
+func (location *jsonPathLocationStruct) pointer() string {
+	tokens := []string{}
+	for current := location; current != nil; current = current.parent {
+		if current.element {
+			tokens = append(tokens, strconv.Itoa(current.index))
+		} else {
+			tokens = append(tokens, current.name)
+		}
+	}
+	for i, j := 0, len(tokens)-1; i < j; i, j = i+1, j-1 {
+		tokens[i], tokens[j] = tokens[j], tokens[i]
+	}
+	return formatJSONPointer(tokens)
+}
+
+func formatNormalizedPathName(name string) string {
+	b := strings.Builder{}
+	b.WriteRune('\'')
+	for _, char := range name {
+		switch char {
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '\\':
+			b.WriteString(`\\`)
+		default:
+			if char < 0x20 {
+				b.WriteString(toStringHexEscapeSequence(char))
+			} else {
+				b.WriteRune(char)
+			}
+		}
+	}
+	b.WriteRune('\'')
+	return b.String()
+}
+
+type jsonPathNodeStruct struct {
+	value    ValueStruct
+	location *jsonPathLocationStruct
+}
+
+func (query *jsonPathQueryStruct) evaluate(root ValueStruct, current ValueStruct) []jsonPathNodeStruct {
+	start := root
+	if query.relative {
+		start = current
+	}
+	nodes := []jsonPathNodeStruct{{value: start}}
+	for _, segment := range query.segments {
+		selected := []jsonPathNodeStruct{}
+		for _, node := range nodes {
+			if segment.descendant {
+				selected = appendDescendantSelections(selected, root, node, segment.selectors)
+			} else {
+				for _, selector := range segment.selectors {
+					selected = selector.appendSelections(selected, root, node)
+				}
+			}
+		}
+		nodes = selected
+	}
+	return nodes
+}
+
+// Applies the selectors to the node and all its descendants in document order.
+func appendDescendantSelections(selected []jsonPathNodeStruct, root ValueStruct, node jsonPathNodeStruct, selectors []jsonPathSelectorStruct) []jsonPathNodeStruct {
+	for _, selector := range selectors {
+		selected = selector.appendSelections(selected, root, node)
+	}
+	for _, child := range jsonPathChildren(node) {
+		selected = appendDescendantSelections(selected, root, child, selectors)
+	}
+	return selected
+}
+
+func jsonPathChildren(node jsonPathNodeStruct) []jsonPathNodeStruct {
+	children := []jsonPathNodeStruct{}
+	switch node.value.kind {
+	case KindObject:
+		for _, key := range node.value.object.Keys {
+			value, _ := node.value.object.get(key)
+			children = append(children, jsonPathNodeStruct{value: value, location: &jsonPathLocationStruct{parent: node.location, name: key}})
+		}
+	case KindArray:
+		for i := range node.value.array.Length {
+			value, _ := node.value.array.get(i)
+			children = append(children, jsonPathNodeStruct{value: value, location: &jsonPathLocationStruct{parent: node.location, index: i, element: true}})
+		}
+	}
+	return children
+}
+
+func (selector *jsonPathSelectorStruct) appendSelections(selected []jsonPathNodeStruct, root ValueStruct, node jsonPathNodeStruct) []jsonPathNodeStruct {
+	switch selector.kind {
+	case jsonPathSelectorName:
+		if node.value.kind != KindObject {
+			return selected
+		}
+		value, ok := node.value.object.get(selector.name)
+		if !ok {
+			return selected
+		}
+		return append(selected, jsonPathNodeStruct{value: value, location: &jsonPathLocationStruct{parent: node.location, name: selector.name}})
+	case jsonPathSelectorWildcard:
+		return append(selected, jsonPathChildren(node)...)
+	case jsonPathSelectorIndex:
+		if node.value.kind != KindArray {
+			return selected
+		}
+		length := int64(node.value.array.Length)
+		index := selector.index
+		if index < 0 {
+			index += length
+		}
+		if index < 0 || index >= length {
+			return selected
+		}
+		value, _ := node.value.array.get(int(index))
+		return append(selected, jsonPathNodeStruct{value: value, location: &jsonPathLocationStruct{parent: node.location, index: int(index), element: true}})
+	case jsonPathSelectorSlice:
+		if node.value.kind != KindArray {
+			return selected
+		}
+		for _, index := range selector.sliceIndexes(int64(node.value.array.Length)) {
+			value, _ := node.value.array.get(int(index))
+			selected = append(selected, jsonPathNodeStruct{value: value, location: &jsonPathLocationStruct{parent: node.location, index: int(index), element: true}})
+		}
+		return selected
+	case jsonPathSelectorFilter:
+		for _, child := range jsonPathChildren(node) {
+			if selector.filter.evaluateLogical(root, child.value) {
+				selected = append(selected, child)
+			}
+		}
+		return selected
+	}
+	return selected
+}
+
+// Returns the selected indexes as defined by RFC 9535 section 2.3.4.2.2.
+func (selector *jsonPathSelectorStruct) sliceIndexes(length int64) []int64 {
+	var step int64 = 1
+	if selector.step != nil {
+		step = *selector.step
+	}
+	if step == 0 {
+		return nil
+	}
+	var start, end int64
+	if step > 0 {
+		start, end = 0, length
+	} else {
+		start, end = length-1, -length-1
+	}
+	if selector.start != nil {
+		start = *selector.start
+	}
+	if selector.end != nil {
+		end = *selector.end
+	}
+	if start < 0 {
+		start += length
+	}
+	if end < 0 {
+		end += length
+	}
+
+	indexes := []int64{}
+	if step > 0 {
+		lower := min(max(start, 0), length)
+		upper := min(max(end, 0), length)
+		for i := lower; i < upper; i += step {
+			indexes = append(indexes, i)
+		}
+	} else {
+		upper := min(max(start, -1), length-1)
+		lower := min(max(end, -1), length-1)
+		for i := upper; lower < i; i += step {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+func (expression *jsonPathOrExpressionStruct) evaluateLogical(root ValueStruct, current ValueStruct) bool {
+	for _, operand := range expression.operands {
+		if operand.evaluateLogical(root, current) {
+			return true
+		}
+	}
+	return false
+}
+
+func (expression *jsonPathAndExpressionStruct) evaluateLogical(root ValueStruct, current ValueStruct) bool {
+	for _, operand := range expression.operands {
+		if !operand.evaluateLogical(root, current) {
+			return false
+		}
+	}
+	return true
+}
+
+func (expression *jsonPathNotExpressionStruct) evaluateLogical(root ValueStruct, current ValueStruct) bool {
+	return !expression.operand.evaluateLogical(root, current)
+}
+
+func (expression *jsonPathExistenceExpressionStruct) evaluateLogical(root ValueStruct, current ValueStruct) bool {
+	return len(expression.query.evaluate(root, current)) > 0
+}
+
+func (expression *jsonPathComparisonExpressionStruct) evaluateLogical(root ValueStruct, current ValueStruct) bool {
+	left, leftOk := expression.left.evaluateValue(root, current)
+	right, rightOk := expression.right.evaluateValue(root, current)
+	switch expression.operator {
+	case "==":
+		return jsonPathEqual(left, leftOk, right, rightOk)
+	case "!=":
+		return !jsonPathEqual(left, leftOk, right, rightOk)
+	case "<":
+		return jsonPathLess(left, leftOk, right, rightOk)
+	case "<=":
+		return jsonPathLess(left, leftOk, right, rightOk) || jsonPathEqual(left, leftOk, right, rightOk)
+	case ">":
+		return jsonPathLess(right, rightOk, left, leftOk)
+	case ">=":
+		return jsonPathLess(right, rightOk, left, leftOk) || jsonPathEqual(left, leftOk, right, rightOk)
+	}
+	return false
+}
+
+// Values that don't exist (Nothing) are only equal to each other.
+func jsonPathEqual(a ValueStruct, aOk bool, b ValueStruct, bOk bool) bool {
+	if !aOk || !bOk {
+		return aOk == bOk
+	}
+	return Equal(a, b, EqualityOptionsStruct{CompareNumbersByValue: true})
+}
+
+// Only numbers and strings can be ordered.
+func jsonPathLess(a ValueStruct, aOk bool, b ValueStruct, bOk bool) bool {
+	if !aOk || !bOk || a.kind != b.kind {
+		return false
+	}
+	switch a.kind {
+	case KindNumber:
+		return compareNumbers(a.s, b.s) < 0
+	case KindString:
+		// Comparing UTF-8 bytes is equivalent to comparing Unicode scalar values.
+		return a.s < b.s
+	}
+	return false
+}
+
+func (literal *jsonPathLiteralStruct) evaluateValue(_ ValueStruct, _ ValueStruct) (ValueStruct, bool) {
+	return literal.value, true
+}
+
+func (query *jsonPathSingularQueryStruct) evaluateValue(root ValueStruct, current ValueStruct) (ValueStruct, bool) {
+	nodes := query.query.evaluate(root, current)
+	if len(nodes) != 1 {
+		return ValueStruct{}, false
+	}
+	return nodes[0].value, true
+}
+
+// Evaluates length(), count(), and value().
+func (function *jsonPathFunctionExpressionStruct) evaluateValue(root ValueStruct, current ValueStruct) (ValueStruct, bool) {
+	switch function.name {
+	case "length":
+		value, ok := function.arguments[0].value.evaluateValue(root, current)
+		if !ok {
+			return ValueStruct{}, false
+		}
+		switch value.kind {
+		case KindString:
+			return NewNumberValue(strconv.Itoa(utf8.RuneCountInString(value.s))), true
+		case KindArray:
+			return NewNumberValue(strconv.Itoa(value.array.Length)), true
+		case KindObject:
+			return NewNumberValue(strconv.Itoa(len(value.object.Keys))), true
+		}
+		return ValueStruct{}, false
+	case "count":
+		nodes := function.arguments[0].query.evaluate(root, current)
+		return NewNumberValue(strconv.Itoa(len(nodes))), true
+	case "value":
+		nodes := function.arguments[0].query.evaluate(root, current)
+		if len(nodes) != 1 {
+			return ValueStruct{}, false
+		}
+		return nodes[0].value, true
+	}
+	return ValueStruct{}, false
+}
+
+// Evaluates match() and search().
+func (function *jsonPathFunctionExpressionStruct) evaluateLogical(root ValueStruct, current ValueStruct) bool {
+	value, ok := function.arguments[0].value.evaluateValue(root, current)
+	if !ok || value.kind != KindString {
+		return false
+	}
+	pattern := function.pattern
+	if pattern == nil {
+		patternValue, ok := function.arguments[1].value.evaluateValue(root, current)
+		if !ok || patternValue.kind != KindString {
+			return false
+		}
+		compiled, err := compileIRegexp(patternValue.s, function.name == "match")
+		if err != nil {
+			return false
+		}
+		pattern = compiled
+	}
+	return pattern.MatchString(value.s)
+}
@@ -0,0 +1,787 @@
+package json
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const (
+	jsonPathSelectorName = iota
+	jsonPathSelectorWildcard
+	jsonPathSelectorIndex
+	jsonPathSelectorSlice
+	jsonPathSelectorFilter
+)
+
+type jsonPathSegmentStruct struct {
+	descendant bool
+	selectors  []jsonPathSelectorStruct
+}
+
+type jsonPathSelectorStruct struct {
+	kind   int
+	name   string
+	index  int64
+	start  *int64
+	end    *int64
+	step   *int64
+	filter jsonPathLogicalExpressionInterface
+}
+
+type jsonPathQueryStruct struct {
+	relative bool
+	segments []jsonPathSegmentStruct
+}
+
+// Reports whether the query always returns at most one node.
+func (query *jsonPathQueryStruct) singular() bool {
+	for _, segment := range query.segments {
+		if segment.descendant || len(segment.selectors) != 1 {
+			return false
+		}
+		kind := segment.selectors[0].kind
+		if kind != jsonPathSelectorName && kind != jsonPathSelectorIndex {
+			return false
+		}
+	}
+	return true
+}
+
+// The types of function parameters and results defined by RFC 9535.
+const (
+	jsonPathValueType = iota
+	jsonPathLogicalType
+	jsonPathNodesType
+)
+
+type jsonPathFunctionDefinitionStruct struct {
+	parameters []int
+	result     int
+}
+
+var jsonPathFunctionDefinitions = map[string]jsonPathFunctionDefinitionStruct{
+	"length": {parameters: []int{jsonPathValueType}, result: jsonPathValueType},
+	"count":  {parameters: []int{jsonPathNodesType}, result: jsonPathValueType},
+	"match":  {parameters: []int{jsonPathValueType, jsonPathValueType}, result: jsonPathLogicalType},
+	"search": {parameters: []int{jsonPathValueType, jsonPathValueType}, result: jsonPathLogicalType},
+	"value":  {parameters: []int{jsonPathNodesType}, result: jsonPathValueType},
+}
+
+// Filter expressions that evaluate to a boolean.
+type jsonPathLogicalExpressionInterface interface {
+	evaluateLogical(root ValueStruct, current ValueStruct) bool
+}
+
+type jsonPathOrExpressionStruct struct {
+	operands []jsonPathLogicalExpressionInterface
+}
+
+type jsonPathAndExpressionStruct struct {
+	operands []jsonPathLogicalExpressionInterface
+}
+
+type jsonPathNotExpressionStruct struct {
+	operand jsonPathLogicalExpressionInterface
+}
+
+type jsonPathComparisonExpressionStruct struct {
+	operator string
+	left     jsonPathComparableInterface
+	right    jsonPathComparableInterface
+}
+
+// Tests whether a query selects any nodes.
+type jsonPathExistenceExpressionStruct struct {
+	query *jsonPathQueryStruct
+}
+
+// Filter expressions that evaluate to a single value or nothing.
+type jsonPathComparableInterface interface {
+	evaluateValue(root ValueStruct, current ValueStruct) (ValueStruct, bool)
+}
+
+type jsonPathLiteralStruct struct {
+	value ValueStruct
+}
+
+type jsonPathSingularQueryStruct struct {
+	query *jsonPathQueryStruct
+}
+
+type jsonPathFunctionExpressionStruct struct {
+	name      string
+	arguments []jsonPathArgumentStruct
+	// Compiled ahead of time if the pattern argument of match() or search() is a literal.
+	pattern *regexp.Regexp
+}
+
+type jsonPathArgumentStruct struct {
+	value jsonPathComparableInterface // If the parameter is a ValueType.
+	query *jsonPathQueryStruct        // If the parameter is a NodesType.
+}
+
+type jsonPathParserStruct struct {
+	s string
+	i int
+}
+
+func parseJSONPathQuery(s string) (*jsonPathQueryStruct, error) {
+	parser := &jsonPathParserStruct{s: s}
+	if !parser.consume("$") {
+		return nil, parser.errorf("expected $")
+	}
+	query, err := parser.parseSegments(false)
+	if err != nil {
+		return nil, err
+	}
+	if parser.i < len(parser.s) {
+		return nil, parser.errorf("unexpected character %s", string(parser.peek()))
+	}
+	return query, nil
+}
+
+func (parser *jsonPathParserStruct) errorf(format string, args ...any) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), parser.i)
+}
+
+func (parser *jsonPathParserStruct) peek() rune {
+	if parser.i >= len(parser.s) {
+		return 0
+	}
+	r, _ := utf8.DecodeRuneInString(parser.s[parser.i:])
+	return r
+}
+
+func (parser *jsonPathParserStruct) consume(prefix string) bool {
+	if strings.HasPrefix(parser.s[parser.i:], prefix) {
+		parser.i += len(prefix)
+		return true
+	}
+	return false
+}
+
+func (parser *jsonPathParserStruct) skipWhitespace() {
+	for parser.i < len(parser.s) {
+		switch parser.s[parser.i] {
+		case ' ', '\t', '\n', '\r':
+			parser.i++
+		default:
+			return
+		}
+	}
+}
+
+// Parses segments following the root or current node identifier.
+func (parser *jsonPathParserStruct) parseSegments(relative bool) (*jsonPathQueryStruct, error) {
+	query := &jsonPathQueryStruct{relative: relative}
+	for {
+		start := parser.i
+		parser.skipWhitespace()
+		if parser.consume("..") {
+			segment, err := parser.parseDescendantSegment()
+			if err != nil {
+				return nil, err
+			}
+			query.segments = append(query.segments, segment)
+			continue
+		}
+		if parser.consume(".") {
+			selector, err := parser.parseDotSelector()
+			if err != nil {
+				return nil, err
+			}
+			query.segments = append(query.segments, jsonPathSegmentStruct{selectors: []jsonPathSelectorStruct{selector}})
+			continue
+		}
+		if parser.peek() == '[' {
+			selectors, err := parser.parseBracketedSelection()
+			if err != nil {
+				return nil, err
+			}
+			query.segments = append(query.segments, jsonPathSegmentStruct{selectors: selectors})
+			continue
+		}
+		// Whitespace is only allowed before segments.
+		parser.i = start
+		return query, nil
+	}
+}
+
+func (parser *jsonPathParserStruct) parseDescendantSegment() (jsonPathSegmentStruct, error) {
+	if parser.peek() == '[' {
+		selectors, err := parser.parseBracketedSelection()
+		if err != nil {
+			return jsonPathSegmentStruct{}, err
+		}
+		return jsonPathSegmentStruct{descendant: true, selectors: selectors}, nil
+	}
+	selector, err := parser.parseDotSelector()
+	if err != nil {
+		return jsonPathSegmentStruct{}, err
+	}
+	return jsonPathSegmentStruct{descendant: true, selectors: []jsonPathSelectorStruct{selector}}, nil
+}
+
+// Parses a wildcard or member name shorthand.
+func (parser *jsonPathParserStruct) parseDotSelector() (jsonPathSelectorStruct, error) {
+	if parser.consume("*") {
+		return jsonPathSelectorStruct{kind: jsonPathSelectorWildcard}, nil
+	}
+	start := parser.i
+	for parser.i < len(parser.s) {
+		r := parser.peek()
+		if !isJSONPathNameCharacter(r) || (parser.i == start && isDigitCharacter(r)) {
+			break
+		}
+		parser.i += utf8.RuneLen(r)
+	}
+	if parser.i == start {
+		return jsonPathSelectorStruct{}, parser.errorf("expected member name")
+	}
+	return jsonPathSelectorStruct{kind: jsonPathSelectorName, name: parser.s[start:parser.i]}, nil
+}
+
+func isJSONPathNameCharacter(r rune) bool {
+	if r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r == '_' || isDigitCharacter(r) {
+		return true
+	}
+	return r >= 0x80 && r != utf8.RuneError && !(r >= 0xd800 && r <= 0xdfff)
+}
+
+func (parser *jsonPathParserStruct) parseBracketedSelection() ([]jsonPathSelectorStruct, error) {
+	parser.consume("[")
+	selectors := []jsonPathSelectorStruct{}
+	for {
+		parser.skipWhitespace()
+		selector, err := parser.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+		parser.skipWhitespace()
+		if parser.consume("]") {
+			return selectors, nil
+		}
+		if !parser.consume(",") {
+			return nil, parser.errorf("expected , or ]")
+		}
+	}
+}
+
+func (parser *jsonPathParserStruct) parseSelector() (jsonPathSelectorStruct, error) {
+	char := parser.peek()
+	if char == '\'' || char == '"' {
+		name, err := parser.parseStringLiteral()
+		if err != nil {
+			return jsonPathSelectorStruct{}, err
+		}
+		return jsonPathSelectorStruct{kind: jsonPathSelectorName, name: name}, nil
+	}
+	if parser.consume("*") {
+		return jsonPathSelectorStruct{kind: jsonPathSelectorWildcard}, nil
+	}
+	if parser.consume("?") {
+		parser.skipWhitespace()
+		filter, err := parser.parseLogicalOrExpression()
+		if err != nil {
+			return jsonPathSelectorStruct{}, err
+		}
+		return jsonPathSelectorStruct{kind: jsonPathSelectorFilter, filter: filter}, nil
+	}
+
+	var start *int64
+	if char == '-' || isDigitCharacter(char) {
+		parsed, err := parser.parseInteger()
+		if err != nil {
+			return jsonPathSelectorStruct{}, err
+		}
+		start = &parsed
+		parser.skipWhitespace()
+	}
+	if !parser.consume(":") {
+		if start == nil {
+			return jsonPathSelectorStruct{}, parser.errorf("expected selector")
+		}
+		return jsonPathSelectorStruct{kind: jsonPathSelectorIndex, index: *start}, nil
+	}
+	selector := jsonPathSelectorStruct{kind: jsonPathSelectorSlice, start: start}
+	parser.skipWhitespace()
+	if char := parser.peek(); char == '-' || isDigitCharacter(char) {
+		parsed, err := parser.parseInteger()
+		if err != nil {
+			return jsonPathSelectorStruct{}, err
+		}
+		selector.end = &parsed
+		parser.skipWhitespace()
+	}
+	if parser.consume(":") {
+		parser.skipWhitespace()
+		if char := parser.peek(); char == '-' || isDigitCharacter(char) {
+			parsed, err := parser.parseInteger()
+			if err != nil {
+				return jsonPathSelectorStruct{}, err
+			}
+			selector.step = &parsed
+		}
+	}
+	return selector, nil
+}
+
+// The range of integers that can be represented exactly by IEEE 754 double precision numbers.
+const maxJSONPathInteger = 1<<53 - 1
+
+func (parser *jsonPathParserStruct) parseInteger() (int64, error) {
+	start := parser.i
+	parser.consume("-")
+	digitStart := parser.i
+	for parser.i < len(parser.s) && isDigitCharacter(rune(parser.s[parser.i])) {
+		parser.i++
+	}
+	digits := parser.s[digitStart:parser.i]
+	if digits == "" || (len(digits) > 1 && digits[0] == '0') || parser.s[start:parser.i] == "-0" {
+		return 0, parser.errorf("invalid integer %s", parser.s[start:parser.i])
+	}
+	parsed, err := strconv.ParseInt(parser.s[start:parser.i], 10, 64)
+	if err != nil || parsed > maxJSONPathInteger || parsed < -maxJSONPathInteger {
+		return 0, parser.errorf("integer %s out of range", parser.s[start:parser.i])
+	}
+	return parsed, nil
+}
+
+func (parser *jsonPathParserStruct) parseStringLiteral() (string, error) {
+	quote := parser.s[parser.i]
+	parser.i++
+	b := strings.Builder{}
+	for {
+		if parser.i >= len(parser.s) {
+			return "", parser.errorf("unterminated string")
+		}
+		r, size := utf8.DecodeRuneInString(parser.s[parser.i:])
+		if r == utf8.RuneError && size == 1 {
+			return "", parser.errorf("invalid encoding")
+		}
+		parser.i += size
+		if r == rune(quote) {
+			return b.String(), nil
+		}
+		if r < 0x20 {
+			return "", parser.errorf("invalid character in string")
+		}
+		if r != '\\' {
+			b.WriteRune(r)
+			continue
+		}
+		if parser.i >= len(parser.s) {
+			return "", parser.errorf("unterminated string")
+		}
+		escaped := parser.s[parser.i]
+		parser.i++
+		switch escaped {
+		case 'b':
+			b.WriteRune('\b')
+		case 'f':
+			b.WriteRune('\f')
+		case 'n':
+			b.WriteRune('\n')
+		case 'r':
+			b.WriteRune('\r')
+		case 't':
+			b.WriteRune('\t')
+		case '/', '\\':
+			b.WriteByte(escaped)
+		case 'u':
+			decoded, err := parser.parseUnicodeEscape()
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(decoded)
+		default:
+			if escaped != quote {
+				return "", parser.errorf("invalid escape sequence")
+			}
+			b.WriteByte(escaped)
+		}
+	}
+}
+
+// Parses the hex digits of a \u escape sequence, including a following low surrogate.
+func (parser *jsonPathParserStruct) parseUnicodeEscape() (rune, error) {
+	high, err := parser.parseHex4()
+	if err != nil {
+		return 0, err
+	}
+	if high >= 0xdc00 && high <= 0xdfff {
+		return 0, parser.errorf("unexpected low surrogate")
+	}
+	if high < 0xd800 || high > 0xdbff {
+		return high, nil
+	}
+	if !parser.consume(`\u`) {
+		return 0, parser.errorf("expected low surrogate")
+	}
+	low, err := parser.parseHex4()
+	if err != nil {
+		return 0, err
+	}
+	decoded := utf16.DecodeRune(high, low)
+	if decoded == utf8.RuneError {
+		return 0, parser.errorf("invalid surrogate pair")
+	}
+	return decoded, nil
+}
+
+func (parser *jsonPathParserStruct) parseHex4() (rune, error) {
+	if parser.i+4 > len(parser.s) {
+		return 0, parser.errorf("invalid hex encoding")
+	}
+	parsed, err := strconv.ParseUint(parser.s[parser.i:parser.i+4], 16, 32)
+	if err != nil {
+		return 0, parser.errorf("invalid hex encoding")
+	}
+	parser.i += 4
+	return rune(parsed), nil
+}
+
+func (parser *jsonPathParserStruct) parseLogicalOrExpression() (jsonPathLogicalExpressionInterface, error) {
+	operands := []jsonPathLogicalExpressionInterface{}
+	for {
+		operand, err := parser.parseLogicalAndExpression()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+		start := parser.i
+		parser.skipWhitespace()
+		if !parser.consume("||") {
+			parser.i = start
+			break
+		}
+		parser.skipWhitespace()
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &jsonPathOrExpressionStruct{operands: operands}, nil
+}
+
+func (parser *jsonPathParserStruct) parseLogicalAndExpression() (jsonPathLogicalExpressionInterface, error) {
+	operands := []jsonPathLogicalExpressionInterface{}
+	for {
+		operand, err := parser.parseBasicExpression()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+		start := parser.i
+		parser.skipWhitespace()
+		if !parser.consume("&&") {
+			parser.i = start
+			break
+		}
+		parser.skipWhitespace()
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &jsonPathAndExpressionStruct{operands: operands}, nil
+}
+
+func (parser *jsonPathParserStruct) parseBasicExpression() (jsonPathLogicalExpressionInterface, error) {
+	if parser.consume("!") {
+		parser.skipWhitespace()
+		if parser.peek() == '(' {
+			operand, err := parser.parseParenthesizedExpression()
+			if err != nil {
+				return nil, err
+			}
+			return &jsonPathNotExpressionStruct{operand: operand}, nil
+		}
+		operand, err := parser.parseTestExpression()
+		if err != nil {
+			return nil, err
+		}
+		return &jsonPathNotExpressionStruct{operand: operand}, nil
+	}
+	if parser.peek() == '(' {
+		return parser.parseParenthesizedExpression()
+	}
+
+	start := parser.i
+	left, leftType, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	afterOperand := parser.i
+	parser.skipWhitespace()
+	operator := parser.parseComparisonOperator()
+	if operator == "" {
+		parser.i = afterOperand
+		switch operand := left.(type) {
+		case *jsonPathQueryStruct:
+			return &jsonPathExistenceExpressionStruct{query: operand}, nil
+		case *jsonPathFunctionExpressionStruct:
+			if leftType == jsonPathValueType {
+				parser.i = start
+				return nil, parser.errorf("function %s() result must be compared", operand.name)
+			}
+			return operand, nil
+		}
+		parser.i = start
+		return nil, parser.errorf("literal must be compared")
+	}
+	parser.skipWhitespace()
+	right, rightType, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	leftComparable, err := parser.toComparable(left, leftType)
+	if err != nil {
+		return nil, err
+	}
+	rightComparable, err := parser.toComparable(right, rightType)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonPathComparisonExpressionStruct{operator: operator, left: leftComparable, right: rightComparable}, nil
+}
+
+func (parser *jsonPathParserStruct) parseParenthesizedExpression() (jsonPathLogicalExpressionInterface, error) {
+	parser.consume("(")
+	parser.skipWhitespace()
+	expression, err := parser.parseLogicalOrExpression()
+	if err != nil {
+		return nil, err
+	}
+	parser.skipWhitespace()
+	if !parser.consume(")") {
+		return nil, parser.errorf("expected )")
+	}
+	return expression, nil
+}
+
+// Parses a filter query or a function returning a LogicalType or NodesType.
+func (parser *jsonPathParserStruct) parseTestExpression() (jsonPathLogicalExpressionInterface, error) {
+	start := parser.i
+	operand, operandType, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	switch operand := operand.(type) {
+	case *jsonPathQueryStruct:
+		return &jsonPathExistenceExpressionStruct{query: operand}, nil
+	case *jsonPathFunctionExpressionStruct:
+		if operandType != jsonPathValueType {
+			return operand, nil
+		}
+	}
+	parser.i = start
+	return nil, parser.errorf("expected filter query or logical function")
+}
+
+func (parser *jsonPathParserStruct) parseComparisonOperator() string {
+	for _, operator := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if parser.consume(operator) {
+			return operator
+		}
+	}
+	return ""
+}
+
+// Parses a literal, filter query, or function expression.
+// Returns the parsed operand and its type.
+func (parser *jsonPathParserStruct) parseOperand() (any, int, error) {
+	char := parser.peek()
+	switch {
+	case char == '@' || char == '$':
+		parser.i++
+		query, err := parser.parseSegments(char == '@')
+		if err != nil {
+			return nil, 0, err
+		}
+		return query, jsonPathNodesType, nil
+	case char == '\'' || char == '"':
+		s, err := parser.parseStringLiteral()
+		if err != nil {
+			return nil, 0, err
+		}
+		return &jsonPathLiteralStruct{value: NewStringValue(s)}, jsonPathValueType, nil
+	case char == '-' || isDigitCharacter(char):
+		lexeme, err := parser.parseNumberLiteral()
+		if err != nil {
+			return nil, 0, err
+		}
+		return &jsonPathLiteralStruct{value: NewNumberValue(lexeme)}, jsonPathValueType, nil
+	case char >= 'a' && char <= 'z':
+		start := parser.i
+		for parser.i < len(parser.s) {
+			c := parser.s[parser.i]
+			if !(c >= 'a' && c <= 'z' || c == '_' || isDigitCharacter(rune(c))) {
+				break
+			}
+			parser.i++
+		}
+		name := parser.s[start:parser.i]
+		if parser.peek() == '(' {
+			function, err := parser.parseFunctionExpression(name)
+			if err != nil {
+				return nil, 0, err
+			}
+			return function, jsonPathFunctionDefinitions[name].result, nil
+		}
+		switch name {
+		case "true":
+			return &jsonPathLiteralStruct{value: NewBoolValue(true)}, jsonPathValueType, nil
+		case "false":
+			return &jsonPathLiteralStruct{value: NewBoolValue(false)}, jsonPathValueType, nil
+		case "null":
+			return &jsonPathLiteralStruct{value: NewNullValue()}, jsonPathValueType, nil
+		}
+		parser.i = start
+		return nil, 0, parser.errorf("unexpected identifier %s", name)
+	}
+	return nil, 0, parser.errorf("expected filter expression")
+}
+
+func (parser *jsonPathParserStruct) toComparable(operand any, operandType int) (jsonPathComparableInterface, error) {
+	switch operand := operand.(type) {
+	case *jsonPathLiteralStruct:
+		return operand, nil
+	case *jsonPathQueryStruct:
+		if !operand.singular() {
+			return nil, parser.errorf("non-singular query cannot be compared")
+		}
+		return &jsonPathSingularQueryStruct{query: operand}, nil
+	case *jsonPathFunctionExpressionStruct:
+		if operandType != jsonPathValueType {
+			return nil, parser.errorf("function %s() result cannot be compared", operand.name)
+		}
+		return operand, nil
+	}
+	return nil, parser.errorf("invalid comparable")
+}
+
+func (parser *jsonPathParserStruct) parseNumberLiteral() (string, error) {
+	start := parser.i
+	parser.consume("-")
+	digitStart := parser.i
+	for parser.i < len(parser.s) && isDigitCharacter(rune(parser.s[parser.i])) {
+		parser.i++
+	}
+	digits := parser.s[digitStart:parser.i]
+	if digits == "" || (len(digits) > 1 && digits[0] == '0') {
+		return "", parser.errorf("invalid number")
+	}
+	if parser.consume(".") {
+		fractionStart := parser.i
+		for parser.i < len(parser.s) && isDigitCharacter(rune(parser.s[parser.i])) {
+			parser.i++
+		}
+		if parser.i == fractionStart {
+			return "", parser.errorf("invalid number")
+		}
+	}
+	if parser.consume("e") || parser.consume("E") {
+		if !parser.consume("-") {
+			parser.consume("+")
+		}
+		exponentStart := parser.i
+		for parser.i < len(parser.s) && isDigitCharacter(rune(parser.s[parser.i])) {
+			parser.i++
+		}
+		if parser.i == exponentStart {
+			return "", parser.errorf("invalid number")
+		}
+	}
+	return parser.s[start:parser.i], nil
+}
+
+func (parser *jsonPathParserStruct) parseFunctionExpression(name string) (*jsonPathFunctionExpressionStruct, error) {
+	definition, ok := jsonPathFunctionDefinitions[name]
+	if !ok {
+		return nil, parser.errorf("unknown function %s()", name)
+	}
+	parser.consume("(")
+	function := &jsonPathFunctionExpressionStruct{name: name}
+	parser.skipWhitespace()
+	if !parser.consume(")") {
+		for {
+			if len(function.arguments) >= len(definition.parameters) {
+				return nil, parser.errorf("too many arguments for %s()", name)
+			}
+			operand, operandType, err := parser.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			argument := jsonPathArgumentStruct{}
+			switch definition.parameters[len(function.arguments)] {
+			case jsonPathValueType:
+				argument.value, err = parser.toComparable(operand, operandType)
+				if err != nil {
+					return nil, err
+				}
+			case jsonPathNodesType:
+				query, ok := operand.(*jsonPathQueryStruct)
+				if !ok {
+					return nil, parser.errorf("argument of %s() must be a filter query", name)
+				}
+				argument.query = query
+			}
+			function.arguments = append(function.arguments, argument)
+			parser.skipWhitespace()
+			if parser.consume(")") {
+				break
+			}
+			if !parser.consume(",") {
+				return nil, parser.errorf("expected , or )")
+			}
+			parser.skipWhitespace()
+		}
+	}
+	if len(function.arguments) != len(definition.parameters) {
+		return nil, parser.errorf("%s() expects %d arguments", name, len(definition.parameters))
+	}
+
+	if name == "match" || name == "search" {
+		if literal, ok := function.arguments[1].value.(*jsonPathLiteralStruct); ok && literal.value.kind == KindString {
+			pattern, err := compileIRegexp(literal.value.s, name == "match")
+			if err == nil {
+				function.pattern = pattern
+			}
+		}
+	}
+	return function, nil
+}
+
+// Compiles an I-Regexp (RFC 9485) pattern with Go's regexp package.
+// The dot is translated to match any character except line feeds and carriage returns.
+func compileIRegexp(pattern string, fullMatch bool) (*regexp.Regexp, error) {
+	b := strings.Builder{}
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		char := pattern[i]
+		switch {
+		case char == '\\' && i+1 < len(pattern):
+			b.WriteByte(char)
+			i++
+			b.WriteByte(pattern[i])
+		case char == '[':
+			inClass = true
+			b.WriteByte(char)
+		case char == ']':
+			inClass = false
+			b.WriteByte(char)
+		case char == '.' && !inClass:
+			b.WriteString(`[^\n\r]`)
+		default:
+			b.WriteByte(char)
+		}
+	}
+	if fullMatch {
+		return regexp.Compile(`^(?:` + b.String() + `)$`)
+	}
+	return regexp.Compile(b.String())
+}
@@ -0,0 +1,110 @@
+package json
+
+import (
+	"strings"
+	"testing"
+)
+
+const jsonPathTestDocument = `{
+	"store": {
+		"book": [
+			{"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+			{"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+			{"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+			{"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+		],
+		"bicycle": {"color": "red", "price": 399}
+	}
+}`
+
+func TestJSONPath(t *testing.T) {
+	doc, err := ParseObject(jsonPathTestDocument)
+	if err != nil {
+		t.Fatalf("failed to parse document: %s", err)
+	}
+
+	successCases := []successTestCaseStruct{
+		{`$.store.book[*].author`, `"Nigel Rees","Evelyn Waugh","Herman Melville","J. R. R. Tolkien"`},
+		{`$..author`, `"Nigel Rees","Evelyn Waugh","Herman Melville","J. R. R. Tolkien"`},
+		{`$.store..price`, `8.95,12.99,8.99,22.99,399`},
+		{`$..book[2].title`, `"Moby Dick"`},
+		{`$..book[-1].title`, `"The Lord of the Rings"`},
+		{`$..book[0,1].title`, `"Sayings of the Century","Sword of Honour"`},
+		{`$..book[:2].title`, `"Sayings of the Century","Sword of Honour"`},
+		{`$..book[::-2].title`, `"The Lord of the Rings","Sword of Honour"`},
+		{`$..book[?@.isbn].title`, `"Moby Dick","The Lord of the Rings"`},
+		{`$..book[?@.price<10].title`, `"Sayings of the Century","Moby Dick"`},
+		{`$..book[?@.price == 8.990].title`, `"Moby Dick"`},
+		{`$..book[?!@.isbn && @.category != 'reference'].title`, `"Sword of Honour"`},
+		{`$..book[?(@.price > 20 || @.author == "Nigel Rees")].title`, `"Sayings of the Century","The Lord of the Rings"`},
+		{`$..book[?length(@.title) > 16].title`, `"Sayings of the Century","The Lord of the Rings"`},
+		{`$..book[?match(@.author, 'J.*')].title`, `"The Lord of the Rings"`},
+		{`$..book[?search(@.author, 'el')].title`, `"Sayings of the Century","Sword of Honour","Moby Dick"`},
+		{`$.store[?count(@.*) == 2]`, `{"color":"red","price":399}`},
+		{`$..book[?value(@..isbn) == '0-553-21311-3'].title`, `"Moby Dick"`},
+		{`$..book[?@.price < $.store.bicycle.price && @.price > 20].title`, `"The Lord of the Rings"`},
+		{`$.store['bicycle'] ['color']`, `"red"`},
+		{`$.missing`, ``},
+	}
+	for _, c := range successCases {
+		path, err := CompileJSONPath(c.input)
+		if err != nil {
+			t.Errorf("error on input: %s: %s", c.input, err)
+			continue
+		}
+		values := path.Values(NewJSONObjectValue(doc))
+		encoded := make([]string, len(values))
+		for i, value := range values {
+			encoded[i] = value.String(MinimalStringCharacterEscapingBehavior)
+		}
+		got := strings.Join(encoded, ",")
+		if got != c.expected {
+			t.Errorf("unexpected output on input %s: %s", c.input, got)
+			continue
+		}
+	}
+
+	failCases := []string{
+		`store`,
+		`$.store `,
+		`$[01]`,
+		`$[-0]`,
+		`$['a`,
+		`$.1a`,
+		`$[?@.*==1]`,
+		`$[?length(@)]`,
+		`$[?match(@, 'a') == true]`,
+		`$[?count(1) > 1]`,
+		`$[?foo(@)]`,
+		`$[?1]`,
+		`$["\'"]`,
+	}
+	for _, c := range failCases {
+		_, err := CompileJSONPath(c)
+		if err == nil {
+			t.Errorf("expected error on input: %s", c)
+			continue
+		}
+	}
+}
+
+func TestJSONPathNodePaths(t *testing.T) {
+	doc, err := ParseObject(`{"a":{"b'c":[1,{"d":2}]}}`)
+	if err != nil {
+		t.Fatalf("failed to parse document: %s", err)
+	}
+	path, err := CompileJSONPath(`$..d`)
+	if err != nil {
+		t.Fatalf("failed to compile query: %s", err)
+	}
+	nodes := path.Query(NewJSONObjectValue(doc))
+	if len(nodes) != 1 {
+		t.Fatalf("unexpected node count: %d", len(nodes))
+	}
+	if got := nodes[0].NormalizedPath(); got != `$['a']['b\'c'][1]['d']` {
+		t.Errorf("unexpected normalized path: %s", got)
+	}
+	if got := nodes[0].Pointer(); got != `/a/b'c/1/d` {
+		t.Errorf("unexpected pointer: %s", got)
+	}
+}
@@ -0,0 +1,456 @@
+package json
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// A compiled JSON Schema (draft 2020-12).
+// Schemas are immutable and can be reused across instances and goroutines.
+// Use [CompileJSONSchema].
+type JSONSchemaStruct struct {
+	root *jsonSchemaNodeStruct
+}
+
+// Compiles a JSON Schema (draft 2020-12).
+// Use [NewJSONObjectValue] to compile a schema object.
+//
+// Supports the applicator and validation vocabularies,
+// and local references with $ref, $defs, and $anchor (e.g. "#/$defs/user" and "#user").
+// The format keyword is an assertion for the date-time, date, time, duration, email, hostname,
+// ipv4, ipv6, uri, uri-reference, uuid, regex, and json-pointer formats. Other formats are ignored.
+// Unknown keywords are ignored.
+//
+// Returns an error if the schema is invalid,
+// a reference cannot be resolved,
+// or the schema uses an unsupported keyword ($dynamicRef, unevaluatedProperties, unevaluatedItems, or $id in subschemas).
+//
+// Regular expressions in pattern and patternProperties are evaluated with Go's regexp package.
+func CompileJSONSchema(schema ValueStruct) (*JSONSchemaStruct, error) {
+	root, err := compileJSONSchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile schema: %s", err.Error())
+	}
+	return &JSONSchemaStruct{root: root}, nil
+}
+
+// The result of a validation in the "basic" output format.
+type JSONSchemaOutputStruct struct {
+	Valid  bool
+	Errors []JSONSchemaOutputUnitStruct
+}
+
+// An error in the "basic" output format.
+type JSONSchemaOutputUnitStruct struct {
+	// The JSON Pointer of the keyword, following references (e.g. /properties/user/$ref/required).
+	KeywordLocation string
+	// The location of the keyword within the schema without references (e.g. #/$defs/user/required).
+	// Empty if the keyword location doesn't follow a reference.
+	AbsoluteKeywordLocation string
+	// The JSON Pointer of the value in the instance (e.g. /user).
+	InstanceLocation string
+	Error            string
+}
+
+// Validates a JSON value against the schema.
+// Use [NewJSONObjectValue] and [NewJSONArrayValue] to validate objects and arrays.
+// All errors are reported, except those of subschemas of anyOf, oneOf, not, if, and contains
+// which only report an error for the keyword itself if the subschemas don't produce a valid result.
+func (schema *JSONSchemaStruct) Validate(instance ValueStruct) JSONSchemaOutputStruct {
+	validator := &jsonSchemaValidatorStruct{}
+	valid := validator.validate(schema.root, instance, jsonSchemaLocationStruct{})
+	return JSONSchemaOutputStruct{Valid: valid, Errors: validator.errors}
+}
+
+// Returns the output as a JSON object in the "basic" output format.
+func (output *JSONSchemaOutputStruct) ToJSONObject() ObjectStruct {
+	object := NewObject()
+	object.SetBool("valid", output.Valid)
+	if output.Valid {
+		return object
+	}
+	errors := NewArray()
+	for _, unit := range output.Errors {
+		unitObject := NewObject()
+		unitObject.SetString("keywordLocation", unit.KeywordLocation)
+		if unit.AbsoluteKeywordLocation != "" {
+			unitObject.SetString("absoluteKeywordLocation", unit.AbsoluteKeywordLocation)
+		}
+		unitObject.SetString("instanceLocation", unit.InstanceLocation)
+		unitObject.SetString("error", unit.Error)
+		errors.AddJSONObject(unitObject)
+	}
+	object.SetJSONArray("errors", errors)
+	return object
+}
+
+// The maximum number of nested references, to stop infinitely recursive schemas.
+const maxJSONSchemaRefDepth = 1024
+
+type jsonSchemaValidatorStruct struct {
+	errors   []JSONSchemaOutputUnitStruct
+	refDepth int
+}
+
+type jsonSchemaLocationStruct struct {
+	keyword  string
+	instance string
+	// True if the keyword location follows a reference.
+	viaRef bool
+}
+
+func (location jsonSchemaLocationStruct) keywordChild(token string) jsonSchemaLocationStruct {
+	location.keyword += "/" + escapeJSONPointerToken(token)
+	return location
+}
+
+func (location jsonSchemaLocationStruct) instanceChild(token string) jsonSchemaLocationStruct {
+	location.instance += "/" + escapeJSONPointerToken(token)
+	return location
+}
+
+func (validator *jsonSchemaValidatorStruct) addError(node *jsonSchemaNodeStruct, location jsonSchemaLocationStruct, keyword string, format string, args ...any) {
+	unit := JSONSchemaOutputUnitStruct{
+		KeywordLocation:  location.keyword,
+		InstanceLocation: location.instance,
+		Error:            fmt.Sprintf(format, args...),
+	}
+	absolute := "#" + node.location
+	if keyword != "" {
+		unit.KeywordLocation += "/" + keyword
+		absolute += "/" + keyword
+	}
+	if location.viaRef {
+		unit.AbsoluteKeywordLocation = absolute
+	}
+	validator.errors = append(validator.errors, unit)
+}
+
+// Validates the instance against a subschema without recording errors.
+func (validator *jsonSchemaValidatorStruct) check(node *jsonSchemaNodeStruct, instance ValueStruct, location jsonSchemaLocationStruct) ([]JSONSchemaOutputUnitStruct, bool) {
+	errors := validator.errors
+	validator.errors = nil
+	valid := validator.validate(node, instance, location)
+	subschemaErrors := validator.errors
+	validator.errors = errors
+	return subschemaErrors, valid
+}
+
+func (validator *jsonSchemaValidatorStruct) validate(node *jsonSchemaNodeStruct, instance ValueStruct, location jsonSchemaLocationStruct) bool {
+	if node.boolean != nil {
+		if !*node.boolean {
+			validator.addError(node, location, "", "no value is allowed")
+		}
+		return *node.boolean
+	}
+	errorCount := len(validator.errors)
+
+	if node.refNode != nil {
+		validator.validateRef(node, instance, location)
+	}
+	if node.types != nil && !jsonSchemaTypeMatches(node.types, instance) {
+		validator.addError(node, location, "type", "expected %s but got %s", strings.Join(node.types, " or "), instance.kind)
+	}
+	if node.hasEnum && !jsonSchemaContains(node.enum, instance) {
+		validator.addError(node, location, "enum", "value is not one of the allowed values")
+	}
+	if node.constValue != nil && !jsonSchemaEqual(*node.constValue, instance) {
+		validator.addError(node, location, "const", "value must be %s", node.constValue.String(MinimalStringCharacterEscapingBehavior))
+	}
+
+	switch instance.kind {
+	case KindNumber:
+		validator.validateNumber(node, instance.s, location)
+	case KindString:
+		validator.validateString(node, instance.s, location)
+	case KindArray:
+		validator.validateArray(node, &instance.array, location)
+	case KindObject:
+		validator.validateObject(node, &instance.object, location)
+	}
+
+	validator.validateCombinators(node, instance, location)
+	return len(validator.errors) == errorCount
+}
+
+func (validator *jsonSchemaValidatorStruct) validateRef(node *jsonSchemaNodeStruct, instance ValueStruct, location jsonSchemaLocationStruct) {
+	refLocation := location.keywordChild("$ref")
+	refLocation.viaRef = true
+	if validator.refDepth >= maxJSONSchemaRefDepth {
+		validator.addError(node, location, "$ref", "maximum reference depth exceeded")
+		return
+	}
+	validator.refDepth++
+	validator.validate(node.refNode, instance, refLocation)
+	validator.refDepth--
+}
+
+func jsonSchemaTypeMatches(types []string, instance ValueStruct) bool {
+	for _, t := range types {
+		if t == instance.kind.String() {
+			return true
+		}
+		if t == "integer" && instance.kind == KindNumber {
+			decimal, err := parseDecimal(instance.s)
+			if err == nil && decimal.isInteger() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Numbers are compared by value (e.g. 1.0 equals 1).
+func jsonSchemaEqual(a ValueStruct, b ValueStruct) bool {
+	return Equal(a, b, EqualityOptionsStruct{CompareNumbersByValue: true})
+}
+
+func jsonSchemaContains(values []ValueStruct, value ValueStruct) bool {
+	for _, v := range values {
+		if jsonSchemaEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (validator *jsonSchemaValidatorStruct) validateNumber(node *jsonSchemaNodeStruct, lexeme string, location jsonSchemaLocationStruct) {
+	if node.multipleOf != nil {
+		ok, err := jsonSchemaIsMultipleOf(lexeme, node.multipleOf)
+		if err != nil {
+			validator.addError(node, location, "multipleOf", "failed to parse number: %s", err.Error())
+		} else if !ok {
+			validator.addError(node, location, "multipleOf", "number must be a multiple of %s", node.multipleOfLexeme)
+		}
+	}
+	if node.maximum != "" && compareNumbers(lexeme, node.maximum) > 0 {
+		validator.addError(node, location, "maximum", "number must be less than or equal to %s", node.maximum)
+	}
+	if node.exclusiveMaximum != "" && compareNumbers(lexeme, node.exclusiveMaximum) >= 0 {
+		validator.addError(node, location, "exclusiveMaximum", "number must be less than %s", node.exclusiveMaximum)
+	}
+	if node.minimum != "" && compareNumbers(lexeme, node.minimum) < 0 {
+		validator.addError(node, location, "minimum", "number must be greater than or equal to %s", node.minimum)
+	}
+	if node.exclusiveMinimum != "" && compareNumbers(lexeme, node.exclusiveMinimum) <= 0 {
+		validator.addError(node, location, "exclusiveMinimum", "number must be greater than %s", node.exclusiveMinimum)
+	}
+}
+
+func jsonSchemaIsMultipleOf(lexeme string, multipleOf *big.Rat) (bool, error) {
+	decimal, err := parseDecimal(lexeme)
+	if err != nil {
+		return false, err
+	}
+	if decimal.isZero() {
+		return true, nil
+	}
+	if decimal.isInteger() && multipleOf.IsInt() {
+		// Uses modular exponentiation since integers like 1e1000000 are too large to expand.
+		digits, _ := new(big.Int).SetString(decimal.digits, 10)
+		remainder := new(big.Int).Exp(big.NewInt(10), big.NewInt(decimal.exponent), multipleOf.Num())
+		remainder.Mul(remainder, digits).Mod(remainder, multipleOf.Num())
+		return remainder.Sign() == 0, nil
+	}
+	value, err := parseRat(lexeme)
+	if err != nil {
+		return false, err
+	}
+	return new(big.Rat).Quo(value, multipleOf).IsInt(), nil
+}
+
+func (validator *jsonSchemaValidatorStruct) validateString(node *jsonSchemaNodeStruct, s string, location jsonSchemaLocationStruct) {
+	if node.maxLength >= 0 || node.minLength >= 0 {
+		length := utf8.RuneCountInString(s)
+		if node.maxLength >= 0 && length > node.maxLength {
+			validator.addError(node, location, "maxLength", "string must have at most %d characters", node.maxLength)
+		}
+		if node.minLength >= 0 && length < node.minLength {
+			validator.addError(node, location, "minLength", "string must have at least %d characters", node.minLength)
+		}
+	}
+	if node.pattern != nil && !node.pattern.MatchString(s) {
+		validator.addError(node, location, "pattern", "string must match pattern %s", strconv.Quote(node.patternSource))
+	}
+	if node.format != "" && !validateJSONSchemaFormat(node.format, s) {
+		validator.addError(node, location, "format", "string must be a valid %s", node.format)
+	}
+}
+
+func (validator *jsonSchemaValidatorStruct) validateArray(node *jsonSchemaNodeStruct, array *ArrayStruct, location jsonSchemaLocationStruct) {
+	if node.maxItems >= 0 && array.Length > node.maxItems {
+		validator.addError(node, location, "maxItems", "array must have at most %d elements", node.maxItems)
+	}
+	if node.minItems >= 0 && array.Length < node.minItems {
+		validator.addError(node, location, "minItems", "array must have at least %d elements", node.minItems)
+	}
+	if node.uniqueItems {
+	unique:
+		for i := range array.Length {
+			a, _ := array.get(i)
+			for j := range i {
+				b, _ := array.get(j)
+				if jsonSchemaEqual(a, b) {
+					validator.addError(node, location, "uniqueItems", "elements %d and %d are equal", j, i)
+					break unique
+				}
+			}
+		}
+	}
+
+	for i, prefixItem := range node.prefixItems {
+		element, ok := array.get(i)
+		if !ok {
+			break
+		}
+		validator.validate(prefixItem, element, location.keywordChild("prefixItems").keywordChild(strconv.Itoa(i)).instanceChild(strconv.Itoa(i)))
+	}
+	if node.items != nil {
+		for i := len(node.prefixItems); i < array.Length; i++ {
+			element, _ := array.get(i)
+			validator.validate(node.items, element, location.keywordChild("items").instanceChild(strconv.Itoa(i)))
+		}
+	}
+
+	if node.contains != nil {
+		count := 0
+		for i := range array.Length {
+			element, _ := array.get(i)
+			_, valid := validator.check(node.contains, element, location.keywordChild("contains").instanceChild(strconv.Itoa(i)))
+			if valid {
+				count++
+			}
+		}
+		minContains := 1
+		if node.minContains >= 0 {
+			minContains = node.minContains
+		}
+		if count < minContains {
+			keyword := "contains"
+			if node.minContains >= 0 {
+				keyword = "minContains"
+			}
+			validator.addError(node, location, keyword, "array must contain at least %d matching elements", minContains)
+		}
+		if node.maxContains >= 0 && count > node.maxContains {
+			validator.addError(node, location, "maxContains", "array must contain at most %d matching elements", node.maxContains)
+		}
+	}
+}
+
+func (validator *jsonSchemaValidatorStruct) validateObject(node *jsonSchemaNodeStruct, object *ObjectStruct, location jsonSchemaLocationStruct) {
+	if node.maxProperties >= 0 && len(object.Keys) > node.maxProperties {
+		validator.addError(node, location, "maxProperties", "object must have at most %d members", node.maxProperties)
+	}
+	if node.minProperties >= 0 && len(object.Keys) < node.minProperties {
+		validator.addError(node, location, "minProperties", "object must have at least %d members", node.minProperties)
+	}
+	for _, name := range node.required {
+		if !object.Has(name) {
+			validator.addError(node, location, "required", "missing required member %s", strconv.Quote(name))
+		}
+	}
+	for _, dependentRequired := range node.dependentRequired {
+		if !object.Has(dependentRequired.name) {
+			continue
+		}
+		for _, name := range dependentRequired.required {
+			if !object.Has(name) {
+				validator.addError(node, location, "dependentRequired/"+escapeJSONPointerToken(dependentRequired.name), "member %s requires member %s", strconv.Quote(dependentRequired.name), strconv.Quote(name))
+			}
+		}
+	}
+	for _, dependentSchema := range node.dependentSchemas {
+		if object.Has(dependentSchema.name) {
+			validator.validate(dependentSchema.schema, NewJSONObjectValue(*object), location.keywordChild("dependentSchemas").keywordChild(dependentSchema.name))
+		}
+	}
+
+	for _, key := range object.Keys {
+		value, _ := object.get(key)
+		memberLocation := location.instanceChild(key)
+		evaluated := false
+		for _, property := range node.properties {
+			if property.name == key {
+				evaluated = true
+				validator.validate(property.schema, value, memberLocation.keywordChild("properties").keywordChild(key))
+				break
+			}
+		}
+		for _, patternProperty := range node.patternProperties {
+			if patternProperty.pattern.MatchString(key) {
+				evaluated = true
+				validator.validate(patternProperty.schema, value, memberLocation.keywordChild("patternProperties").keywordChild(patternProperty.source))
+			}
+		}
+		if !evaluated && node.additionalProperties != nil {
+			validator.validate(node.additionalProperties, value, memberLocation.keywordChild("additionalProperties"))
+		}
+		if node.propertyNames != nil {
+			validator.validate(node.propertyNames, NewStringValue(key), memberLocation.keywordChild("propertyNames"))
+		}
+	}
+}
+
+func (validator *jsonSchemaValidatorStruct) validateCombinators(node *jsonSchemaNodeStruct, instance ValueStruct, location jsonSchemaLocationStruct) {
+	for i, subschema := range node.allOf {
+		validator.validate(subschema, instance, location.keywordChild("allOf").keywordChild(strconv.Itoa(i)))
+	}
+
+	if node.anyOf != nil {
+		errors := []JSONSchemaOutputUnitStruct{}
+		valid := false
+		for i, subschema := range node.anyOf {
+			subschemaErrors, subschemaValid := validator.check(subschema, instance, location.keywordChild("anyOf").keywordChild(strconv.Itoa(i)))
+			if subschemaValid {
+				valid = true
+				break
+			}
+			errors = append(errors, subschemaErrors...)
+		}
+		if !valid {
+			validator.addError(node, location, "anyOf", "value must match at least one subschema")
+			validator.errors = append(validator.errors, errors...)
+		}
+	}
+
+	if node.oneOf != nil {
+		errors := []JSONSchemaOutputUnitStruct{}
+		matches := []int{}
+		for i, subschema := range node.oneOf {
+			subschemaErrors, subschemaValid := validator.check(subschema, instance, location.keywordChild("oneOf").keywordChild(strconv.Itoa(i)))
+			if subschemaValid {
+				matches = append(matches, i)
+			}
+			errors = append(errors, subschemaErrors...)
+		}
+		switch len(matches) {
+		case 0:
+			validator.addError(node, location, "oneOf", "value must match exactly one subschema")
+			validator.errors = append(validator.errors, errors...)
+		case 1:
+		default:
+			validator.addError(node, location, "oneOf", "value must match exactly one subschema but matches subschemas %d and %d", matches[0], matches[1])
+		}
+	}
+
+	if node.not != nil {
+		_, valid := validator.check(node.not, instance, location.keywordChild("not"))
+		if valid {
+			validator.addError(node, location, "not", "value must not match the subschema")
+		}
+	}
+
+	if node.ifSchema != nil {
+		_, valid := validator.check(node.ifSchema, instance, location.keywordChild("if"))
+		if valid && node.thenSchema != nil {
+			validator.validate(node.thenSchema, instance, location.keywordChild("then"))
+		}
+		if !valid && node.elseSchema != nil {
+			validator.validate(node.elseSchema, instance, location.keywordChild("else"))
+		}
+	}
+}
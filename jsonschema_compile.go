@@ -0,0 +1,456 @@
+package json
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// A compiled schema or subschema.
+type jsonSchemaNodeStruct struct {
+	// The JSON Pointer of the schema within the root schema.
+	location string
+	// Set for boolean schemas.
+	boolean *bool
+
+	ref     string
+	refNode *jsonSchemaNodeStruct
+
+	types            []string
+	enum             []ValueStruct
+	hasEnum          bool
+	constValue       *ValueStruct
+	multipleOf       *big.Rat
+	multipleOfLexeme string
+	maximum          string
+	exclusiveMaximum string
+	minimum          string
+	exclusiveMinimum string
+
+	maxLength     int
+	minLength     int
+	pattern       *regexp.Regexp
+	patternSource string
+	format        string
+
+	maxItems    int
+	minItems    int
+	uniqueItems bool
+	maxContains int
+	minContains int
+
+	maxProperties     int
+	minProperties     int
+	required          []string
+	dependentRequired []jsonSchemaDependentRequiredStruct
+
+	properties           []jsonSchemaPropertyStruct
+	patternProperties    []jsonSchemaPatternPropertyStruct
+	additionalProperties *jsonSchemaNodeStruct
+	propertyNames        *jsonSchemaNodeStruct
+	dependentSchemas     []jsonSchemaPropertyStruct
+
+	prefixItems []*jsonSchemaNodeStruct
+	items       *jsonSchemaNodeStruct
+	contains    *jsonSchemaNodeStruct
+
+	allOf      []*jsonSchemaNodeStruct
+	anyOf      []*jsonSchemaNodeStruct
+	oneOf      []*jsonSchemaNodeStruct
+	not        *jsonSchemaNodeStruct
+	ifSchema   *jsonSchemaNodeStruct
+	thenSchema *jsonSchemaNodeStruct
+	elseSchema *jsonSchemaNodeStruct
+}
+
+type jsonSchemaPropertyStruct struct {
+	name   string
+	schema *jsonSchemaNodeStruct
+}
+
+type jsonSchemaPatternPropertyStruct struct {
+	source  string
+	pattern *regexp.Regexp
+	schema  *jsonSchemaNodeStruct
+}
+
+type jsonSchemaDependentRequiredStruct struct {
+	name     string
+	required []string
+}
+
+type jsonSchemaCompilerStruct struct {
+	root ValueStruct
+	id   string
+	// Compiled schemas by JSON Pointer.
+	nodes   map[string]*jsonSchemaNodeStruct
+	anchors map[string]*jsonSchemaNodeStruct
+	// Schemas with unresolved references.
+	refs []*jsonSchemaNodeStruct
+}
+
+func compileJSONSchema(root ValueStruct) (*jsonSchemaNodeStruct, error) {
+	compiler := &jsonSchemaCompilerStruct{
+		root:    root,
+		nodes:   map[string]*jsonSchemaNodeStruct{},
+		anchors: map[string]*jsonSchemaNodeStruct{},
+	}
+	if root.kind == KindObject {
+		if dialect, ok := root.object.get("$schema"); ok {
+			if dialect.kind != KindString || strings.TrimSuffix(dialect.s, "#") != jsonSchemaDialect {
+				return nil, fmt.Errorf("unsupported dialect %s", dialect.String(MinimalStringCharacterEscapingBehavior))
+			}
+		}
+		if id, ok := root.object.get("$id"); ok {
+			if id.kind != KindString {
+				return nil, fmt.Errorf("invalid $id")
+			}
+			compiler.id, _, _ = strings.Cut(id.s, "#")
+		}
+	}
+	node, err := compiler.compile(root, "")
+	if err != nil {
+		return nil, err
+	}
+	// Resolving references may compile new schemas with references.
+	for len(compiler.refs) > 0 {
+		refNode := compiler.refs[0]
+		compiler.refs = compiler.refs[1:]
+		resolved, err := compiler.resolveRef(refNode.ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve $ref at %s: %w", strconv.Quote(refNode.location+"/$ref"), err)
+		}
+		refNode.refNode = resolved
+	}
+	return node, nil
+}
+
+func (compiler *jsonSchemaCompilerStruct) resolveRef(ref string) (*jsonSchemaNodeStruct, error) {
+	base, fragment, _ := strings.Cut(ref, "#")
+	if base != "" && base != compiler.id {
+		return nil, fmt.Errorf("unsupported non-local reference %s", ref)
+	}
+	fragment, err := url.PathUnescape(fragment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference %s", ref)
+	}
+	if fragment != "" && fragment[0] != '/' {
+		node, ok := compiler.anchors[fragment]
+		if !ok {
+			return nil, fmt.Errorf("anchor %s %w", fragment, ErrNotFound)
+		}
+		return node, nil
+	}
+	if node, ok := compiler.nodes[fragment]; ok {
+		return node, nil
+	}
+	tokens, err := parseJSONPointer(fragment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference %s: %s", ref, err.Error())
+	}
+	target, err := resolveJSONPointer(compiler.root, tokens)
+	if err != nil {
+		return nil, err
+	}
+	return compiler.compile(target, fragment)
+}
+
+func (compiler *jsonSchemaCompilerStruct) compile(schema ValueStruct, location string) (*jsonSchemaNodeStruct, error) {
+	if node, ok := compiler.nodes[location]; ok {
+		return node, nil
+	}
+	node := &jsonSchemaNodeStruct{
+		location:      location,
+		maxLength:     -1,
+		minLength:     -1,
+		maxItems:      -1,
+		minItems:      -1,
+		maxContains:   -1,
+		minContains:   -1,
+		maxProperties: -1,
+		minProperties: -1,
+	}
+	compiler.nodes[location] = node
+	switch schema.kind {
+	case KindBool:
+		node.boolean = &schema.b
+		return node, nil
+	case KindObject:
+	default:
+		return nil, fmt.Errorf("schema at %s must be an object or boolean", strconv.Quote(location))
+	}
+	object := schema.object
+
+	for _, keyword := range []string{"$dynamicRef", "$dynamicAnchor", "$recursiveRef", "unevaluatedProperties", "unevaluatedItems"} {
+		if object.Has(keyword) {
+			return nil, fmt.Errorf("unsupported keyword %s at %s", keyword, strconv.Quote(location))
+		}
+	}
+	if location != "" && object.Has("$id") {
+		return nil, fmt.Errorf("unsupported keyword $id at %s", strconv.Quote(location))
+	}
+
+	for _, key := range object.Keys {
+		value, _ := object.get(key)
+		keywordLocation := location + "/" + escapeJSONPointerToken(key)
+		err := compiler.compileKeyword(node, key, value, keywordLocation)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+func (compiler *jsonSchemaCompilerStruct) compileKeyword(node *jsonSchemaNodeStruct, keyword string, value ValueStruct, location string) error {
+	var err error
+	switch keyword {
+	case "$ref":
+		node.ref, err = jsonSchemaString(value, location)
+		compiler.refs = append(compiler.refs, node)
+	case "$anchor":
+		var anchor string
+		anchor, err = jsonSchemaString(value, location)
+		compiler.anchors[anchor] = node
+	case "$defs":
+		_, err = compiler.compileSchemaMap(value, location)
+	case "type":
+		node.types, err = jsonSchemaTypes(value, location)
+	case "enum":
+		if value.kind != KindArray {
+			return jsonSchemaKeywordError(location, "an array")
+		}
+		node.hasEnum = true
+		for i := range value.array.Length {
+			element, _ := value.array.get(i)
+			node.enum = append(node.enum, element)
+		}
+	case "const":
+		node.constValue = &value
+	case "multipleOf":
+		node.multipleOfLexeme, err = jsonSchemaNumber(value, location)
+		if err == nil {
+			node.multipleOf, err = parseRat(value.s)
+			if err == nil && node.multipleOf.Sign() <= 0 {
+				return jsonSchemaKeywordError(location, "a number greater than 0")
+			}
+		}
+	case "maximum":
+		node.maximum, err = jsonSchemaNumber(value, location)
+	case "exclusiveMaximum":
+		node.exclusiveMaximum, err = jsonSchemaNumber(value, location)
+	case "minimum":
+		node.minimum, err = jsonSchemaNumber(value, location)
+	case "exclusiveMinimum":
+		node.exclusiveMinimum, err = jsonSchemaNumber(value, location)
+	case "maxLength":
+		node.maxLength, err = jsonSchemaNonNegativeInteger(value, location)
+	case "minLength":
+		node.minLength, err = jsonSchemaNonNegativeInteger(value, location)
+	case "pattern":
+		node.patternSource, err = jsonSchemaString(value, location)
+		if err == nil {
+			node.pattern, err = jsonSchemaRegexp(node.patternSource, location)
+		}
+	case "format":
+		node.format, err = jsonSchemaString(value, location)
+	case "maxItems":
+		node.maxItems, err = jsonSchemaNonNegativeInteger(value, location)
+	case "minItems":
+		node.minItems, err = jsonSchemaNonNegativeInteger(value, location)
+	case "uniqueItems":
+		if value.kind != KindBool {
+			return jsonSchemaKeywordError(location, "a boolean")
+		}
+		node.uniqueItems = value.b
+	case "maxContains":
+		node.maxContains, err = jsonSchemaNonNegativeInteger(value, location)
+	case "minContains":
+		node.minContains, err = jsonSchemaNonNegativeInteger(value, location)
+	case "maxProperties":
+		node.maxProperties, err = jsonSchemaNonNegativeInteger(value, location)
+	case "minProperties":
+		node.minProperties, err = jsonSchemaNonNegativeInteger(value, location)
+	case "required":
+		node.required, err = jsonSchemaStrings(value, location)
+	case "dependentRequired":
+		if value.kind != KindObject {
+			return jsonSchemaKeywordError(location, "an object")
+		}
+		for _, key := range value.object.Keys {
+			member, _ := value.object.get(key)
+			required, err := jsonSchemaStrings(member, location+"/"+escapeJSONPointerToken(key))
+			if err != nil {
+				return err
+			}
+			node.dependentRequired = append(node.dependentRequired, jsonSchemaDependentRequiredStruct{name: key, required: required})
+		}
+	case "properties":
+		node.properties, err = compiler.compileSchemaMap(value, location)
+	case "patternProperties":
+		var properties []jsonSchemaPropertyStruct
+		properties, err = compiler.compileSchemaMap(value, location)
+		for _, property := range properties {
+			pattern, err := jsonSchemaRegexp(property.name, location+"/"+escapeJSONPointerToken(property.name))
+			if err != nil {
+				return err
+			}
+			node.patternProperties = append(node.patternProperties, jsonSchemaPatternPropertyStruct{source: property.name, pattern: pattern, schema: property.schema})
+		}
+	case "additionalProperties":
+		node.additionalProperties, err = compiler.compile(value, location)
+	case "propertyNames":
+		node.propertyNames, err = compiler.compile(value, location)
+	case "dependentSchemas":
+		node.dependentSchemas, err = compiler.compileSchemaMap(value, location)
+	case "prefixItems":
+		node.prefixItems, err = compiler.compileSchemaArray(value, location)
+	case "items":
+		node.items, err = compiler.compile(value, location)
+	case "contains":
+		node.contains, err = compiler.compile(value, location)
+	case "allOf":
+		node.allOf, err = compiler.compileSchemaArray(value, location)
+	case "anyOf":
+		node.anyOf, err = compiler.compileSchemaArray(value, location)
+	case "oneOf":
+		node.oneOf, err = compiler.compileSchemaArray(value, location)
+	case "not":
+		node.not, err = compiler.compile(value, location)
+	case "if":
+		node.ifSchema, err = compiler.compile(value, location)
+	case "then":
+		node.thenSchema, err = compiler.compile(value, location)
+	case "else":
+		node.elseSchema, err = compiler.compile(value, location)
+	}
+	// Unknown keywords are ignored.
+	return err
+}
+
+func (compiler *jsonSchemaCompilerStruct) compileSchemaMap(value ValueStruct, location string) ([]jsonSchemaPropertyStruct, error) {
+	if value.kind != KindObject {
+		return nil, jsonSchemaKeywordError(location, "an object")
+	}
+	result := make([]jsonSchemaPropertyStruct, 0, len(value.object.Keys))
+	for _, key := range value.object.Keys {
+		member, _ := value.object.get(key)
+		schema, err := compiler.compile(member, location+"/"+escapeJSONPointerToken(key))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, jsonSchemaPropertyStruct{name: key, schema: schema})
+	}
+	return result, nil
+}
+
+func (compiler *jsonSchemaCompilerStruct) compileSchemaArray(value ValueStruct, location string) ([]*jsonSchemaNodeStruct, error) {
+	if value.kind != KindArray || value.array.Length == 0 {
+		return nil, jsonSchemaKeywordError(location, "a non-empty array")
+	}
+	result := make([]*jsonSchemaNodeStruct, value.array.Length)
+	for i := range value.array.Length {
+		element, _ := value.array.get(i)
+		schema, err := compiler.compile(element, location+"/"+strconv.Itoa(i))
+		if err != nil {
+			return nil, err
+		}
+		result[i] = schema
+	}
+	return result, nil
+}
+
+func jsonSchemaKeywordError(location string, expected string) error {
+	return fmt.Errorf("keyword at %s must be %s", strconv.Quote(location), expected)
+}
+
+func jsonSchemaString(value ValueStruct, location string) (string, error) {
+	if value.kind != KindString {
+		return "", jsonSchemaKeywordError(location, "a string")
+	}
+	return value.s, nil
+}
+
+func jsonSchemaStrings(value ValueStruct, location string) ([]string, error) {
+	if value.kind != KindArray {
+		return nil, jsonSchemaKeywordError(location, "an array of strings")
+	}
+	result := make([]string, value.array.Length)
+	for i := range value.array.Length {
+		element, _ := value.array.get(i)
+		if element.kind != KindString {
+			return nil, jsonSchemaKeywordError(location, "an array of strings")
+		}
+		result[i] = element.s
+	}
+	return result, nil
+}
+
+func jsonSchemaNumber(value ValueStruct, location string) (string, error) {
+	if value.kind != KindNumber {
+		return "", jsonSchemaKeywordError(location, "a number")
+	}
+	return value.s, nil
+}
+
+func jsonSchemaNonNegativeInteger(value ValueStruct, location string) (int, error) {
+	if value.kind != KindNumber {
+		return 0, jsonSchemaKeywordError(location, "a non-negative integer")
+	}
+	// Numbers with a zero fractional part (e.g. 1.0) are integers in JSON Schema.
+	decimal, err := parseDecimal(value.s)
+	if err != nil || decimal.negative && !decimal.isZero() || !decimal.isInteger() {
+		return 0, jsonSchemaKeywordError(location, "a non-negative integer")
+	}
+	if decimal.isZero() {
+		return 0, nil
+	}
+	// The exponent is checked first since adding the length can overflow.
+	if decimal.exponent > 10 || decimal.exponent+int64(len(decimal.digits)) > 10 {
+		return 0, jsonSchemaKeywordError(location, "a non-negative integer")
+	}
+	parsed, err := strconv.ParseUint(decimal.digits+strings.Repeat("0", int(decimal.exponent)), 10, 31)
+	if err != nil {
+		return 0, jsonSchemaKeywordError(location, "a non-negative integer")
+	}
+	return int(parsed), nil
+}
+
+var jsonSchemaTypeNames = []string{"null", "boolean", "object", "array", "number", "string", "integer"}
+
+func jsonSchemaTypes(value ValueStruct, location string) ([]string, error) {
+	var types []string
+	switch value.kind {
+	case KindString:
+		types = []string{value.s}
+	case KindArray:
+		var err error
+		types, err = jsonSchemaStrings(value, location)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, jsonSchemaKeywordError(location, "a string or an array of strings")
+	}
+	for _, t := range types {
+		if !slices.Contains(jsonSchemaTypeNames, t) {
+			return nil, fmt.Errorf("unknown type %s at %s", strconv.Quote(t), strconv.Quote(location))
+		}
+	}
+	return types, nil
+}
+
+// Patterns are evaluated with Go's regexp package, which doesn't support some ECMA-262 features
+// such as lookarounds and backreferences.
+func jsonSchemaRegexp(pattern string, location string) (*regexp.Regexp, error) {
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern at %s: %s", strconv.Quote(location), err.Error())
+	}
+	return compiled, nil
+}
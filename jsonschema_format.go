@@ -0,0 +1,77 @@
+package json
+
+import (
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	jsonSchemaDurationPattern = regexp.MustCompile(`^P(?:\d+W|(?:\d+Y)?(?:\d+M)?(?:\d+D)?(?:T(?:\d+H)?(?:\d+M)?(?:\d+S)?)?)$`)
+	jsonSchemaUUIDPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	jsonSchemaHostnameLabel   = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	jsonSchemaTimePattern     = regexp.MustCompile(`^\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:[zZ]|[+-]\d{2}:\d{2})$`)
+)
+
+// Returns true if the string is valid or the format is unknown.
+func validateJSONSchemaFormat(format string, s string) bool {
+	switch format {
+	case "date-time":
+		// RFC 3339 allows a lowercase T and Z.
+		_, err := time.Parse(time.RFC3339Nano, strings.ToUpper(s))
+		return err == nil
+	case "date":
+		_, err := time.Parse(time.DateOnly, s)
+		return err == nil
+	case "time":
+		if !jsonSchemaTimePattern.MatchString(s) {
+			return false
+		}
+		_, err := time.Parse("15:04:05.999999999Z07:00", strings.ToUpper(s))
+		return err == nil
+	case "duration":
+		return jsonSchemaDurationPattern.MatchString(s) && s != "P" && !strings.HasSuffix(s, "T")
+	case "email":
+		address, err := mail.ParseAddress(s)
+		return err == nil && address.Address == s
+	case "hostname":
+		return isJSONSchemaHostname(s)
+	case "ipv4":
+		address, err := netip.ParseAddr(s)
+		return err == nil && address.Is4()
+	case "ipv6":
+		address, err := netip.ParseAddr(s)
+		return err == nil && address.Is6() && address.Zone() == ""
+	case "uri":
+		parsed, err := url.Parse(s)
+		return err == nil && parsed.IsAbs()
+	case "uri-reference":
+		_, err := url.Parse(s)
+		return err == nil
+	case "uuid":
+		return jsonSchemaUUIDPattern.MatchString(s)
+	case "regex":
+		_, err := regexp.Compile(s)
+		return err == nil
+	case "json-pointer":
+		_, err := parseJSONPointer(s)
+		return err == nil
+	}
+	return true
+}
+
+func isJSONSchemaHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return false
+	}
+	for label := range strings.SplitSeq(s, ".") {
+		if !jsonSchemaHostnameLabel.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,205 @@
+package json
+
+import (
+	"testing"
+)
+
+func TestJSONSchemaValidate(t *testing.T) {
+	testCases := []jsonSchemaTestCaseStruct{
+		{`true`, `1`, true},
+		{`false`, `1`, false},
+		{`{"type":"string"}`, `"a"`, true},
+		{`{"type":"string"}`, `1`, false},
+		{`{"type":["string","null"]}`, `null`, true},
+		{`{"type":"integer"}`, `1.0`, true},
+		{`{"type":"integer"}`, `1e3`, true},
+		{`{"type":"integer"}`, `1.5`, false},
+		{`{"type":"number"}`, `1`, true},
+		{`{"enum":[1,"a",{"b":[true]}]}`, `{"b":[true]}`, true},
+		{`{"enum":[1,"a"]}`, `1.0`, true},
+		{`{"enum":[1,"a"]}`, `"b"`, false},
+		{`{"const":{"a":1,"b":2}}`, `{"b":2,"a":1}`, true},
+		{`{"const":null}`, `false`, false},
+		{`{"multipleOf":0.01}`, `19.99`, true},
+		{`{"multipleOf":0.01}`, `19.999`, false},
+		{`{"multipleOf":3}`, `-9`, true},
+		{`{"multipleOf":3}`, `1e1000000`, false},
+		{`{"multipleOf":5}`, `1e1000000`, true},
+		{`{"multipleOf":4}`, `1.2e9223372036854775807`, true},
+		{`{"maximum":10}`, `10`, true},
+		{`{"maximum":10}`, `10.000000000000000000001`, false},
+		{`{"exclusiveMaximum":10}`, `10`, false},
+		{`{"minimum":-1}`, `-1`, true},
+		{`{"exclusiveMinimum":-1}`, `-1`, false},
+		{`{"minimum":1}`, `"0"`, true},
+		{`{"maxLength":2}`, `"日本"`, true},
+		{`{"maxLength":2}`, `"abc"`, false},
+		{`{"maxLength":2.0}`, `"abc"`, false},
+		{`{"maxLength":1e1}`, `"abc"`, true},
+		{`{"minLength":1}`, `""`, false},
+		{`{"pattern":"^[a-z]+$"}`, `"abc"`, true},
+		{`{"pattern":"b"}`, `"abc"`, true},
+		{`{"pattern":"^[a-z]+$"}`, `"ABC"`, false},
+		{`{"format":"email"}`, `"user@example.com"`, true},
+		{`{"format":"email"}`, `"user"`, false},
+		{`{"format":"date-time"}`, `"2024-01-02T03:04:05.5+09:00"`, true},
+		{`{"format":"date-time"}`, `"2024-01-02"`, false},
+		{`{"format":"date"}`, `"2024-02-30"`, false},
+		{`{"format":"time"}`, `"03:04:05Z"`, true},
+		{`{"format":"duration"}`, `"P1DT2H"`, true},
+		{`{"format":"duration"}`, `"PT"`, false},
+		{`{"format":"ipv4"}`, `"192.168.0.1"`, true},
+		{`{"format":"ipv4"}`, `"::1"`, false},
+		{`{"format":"ipv6"}`, `"::1"`, true},
+		{`{"format":"hostname"}`, `"example.com"`, true},
+		{`{"format":"hostname"}`, `"-example.com"`, false},
+		{`{"format":"uri"}`, `"https://example.com/a?b"`, true},
+		{`{"format":"uri"}`, `"/a"`, false},
+		{`{"format":"uuid"}`, `"123e4567-e89b-12d3-a456-426614174000"`, true},
+		{`{"format":"json-pointer"}`, `"a/b"`, false},
+		{`{"format":"unknown"}`, `"a"`, true},
+		{`{"format":"email"}`, `1`, true},
+		{`{"minItems":1,"maxItems":2}`, `[1,2]`, true},
+		{`{"maxItems":2}`, `[1,2,3]`, false},
+		{`{"uniqueItems":true}`, `[1,{"a":1},{"a":1.0}]`, false},
+		{`{"uniqueItems":true}`, `[1,"1"]`, true},
+		{`{"prefixItems":[{"type":"string"}],"items":{"type":"number"}}`, `["a",1,2]`, true},
+		{`{"prefixItems":[{"type":"string"}],"items":{"type":"number"}}`, `[1]`, false},
+		{`{"prefixItems":[{"type":"string"}],"items":false}`, `["a","b"]`, false},
+		{`{"contains":{"type":"string"}}`, `[1,"a"]`, true},
+		{`{"contains":{"type":"string"}}`, `[1]`, false},
+		{`{"contains":{"type":"string"},"minContains":0}`, `[1]`, true},
+		{`{"contains":{"type":"string"},"maxContains":1}`, `["a","b"]`, false},
+		{`{"required":["a"]}`, `{"a":null}`, true},
+		{`{"required":["a"]}`, `{"b":1}`, false},
+		{`{"required":["a"]}`, `[]`, true},
+		{`{"minProperties":1,"maxProperties":1}`, `{"a":1}`, true},
+		{`{"maxProperties":1}`, `{"a":1,"b":2}`, false},
+		{`{"properties":{"a":{"type":"string"}}}`, `{"a":1}`, false},
+		{`{"properties":{"a":{"type":"string"}},"additionalProperties":false}`, `{"a":"x"}`, true},
+		{`{"properties":{"a":{"type":"string"}},"additionalProperties":false}`, `{"a":"x","b":1}`, false},
+		{`{"patternProperties":{"^x-":{"type":"string"}},"additionalProperties":false}`, `{"x-a":"1"}`, true},
+		{`{"patternProperties":{"^x-":{"type":"string"}},"additionalProperties":false}`, `{"x-a":1}`, false},
+		{`{"propertyNames":{"maxLength":1}}`, `{"ab":1}`, false},
+		{`{"dependentRequired":{"a":["b"]}}`, `{"a":1}`, false},
+		{`{"dependentRequired":{"a":["b"]}}`, `{"b":1}`, true},
+		{`{"dependentSchemas":{"a":{"required":["b"]}}}`, `{"a":1}`, false},
+		{`{"allOf":[{"type":"number"},{"minimum":2}]}`, `1`, false},
+		{`{"anyOf":[{"type":"number"},{"type":"string"}]}`, `"a"`, true},
+		{`{"anyOf":[{"type":"number"},{"type":"string"}]}`, `true`, false},
+		{`{"oneOf":[{"type":"number"},{"type":"integer"}]}`, `1.5`, true},
+		{`{"oneOf":[{"type":"number"},{"type":"integer"}]}`, `1`, false},
+		{`{"not":{"type":"string"}}`, `"a"`, false},
+		{`{"if":{"type":"string"},"then":{"minLength":2},"else":{"minimum":2}}`, `"ab"`, true},
+		{`{"if":{"type":"string"},"then":{"minLength":2},"else":{"minimum":2}}`, `"a"`, false},
+		{`{"if":{"type":"string"},"then":{"minLength":2},"else":{"minimum":2}}`, `1`, false},
+		{`{"$defs":{"positive":{"exclusiveMinimum":0}},"items":{"$ref":"#/$defs/positive"}}`, `[1,2]`, true},
+		{`{"$defs":{"positive":{"exclusiveMinimum":0}},"items":{"$ref":"#/$defs/positive"}}`, `[1,0]`, false},
+		{`{"$defs":{"a":{"$anchor":"node","type":"object","properties":{"next":{"$ref":"#node"}}}},"$ref":"#node"}`, `{"next":{"next":{}}}`, true},
+		{`{"$defs":{"a":{"$anchor":"node","type":"object","properties":{"next":{"$ref":"#node"}}}},"$ref":"#node"}`, `{"next":{"next":1}}`, false},
+		{`{"properties":{"a":{"type":"integer"},"b":{"$ref":"#/properties/a"}}}`, `{"b":1.5}`, false},
+		{`{"$id":"https://example.com/schema","$defs":{"a":{"type":"string"}},"$ref":"https://example.com/schema#/$defs/a"}`, `"a"`, true},
+		{`{"definitions":{"a%b":{"type":"string"}},"$ref":"#/definitions/a%25b"}`, `1`, false},
+		{`{"$defs":{"a":{"$ref":"#/$defs/a"}},"$ref":"#/$defs/a"}`, `1`, false},
+	}
+	for _, c := range testCases {
+		schemaValue, err := ParseValue(c.schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		schema, err := CompileJSONSchema(schemaValue)
+		if err != nil {
+			t.Errorf("error on schema %s: %s", c.schema, err)
+			continue
+		}
+		instance, err := ParseValue(c.instance)
+		if err != nil {
+			t.Fatal(err)
+		}
+		output := schema.Validate(instance)
+		if output.Valid != c.valid {
+			t.Errorf("unexpected result on schema %s and instance %s: %#v", c.schema, c.instance, output)
+		}
+		if output.Valid != (len(output.Errors) == 0) {
+			t.Errorf("unexpected errors on schema %s and instance %s: %#v", c.schema, c.instance, output)
+		}
+	}
+}
+
+func TestCompileJSONSchemaError(t *testing.T) {
+	failCases := []string{
+		`1`,
+		`{"type":"text"}`,
+		`{"minLength":-1}`,
+		`{"minLength":1.5}`,
+		`{"maxLength":1e9223372036854775807}`,
+		`{"maxLength":2147483648}`,
+		`{"required":"a"}`,
+		`{"pattern":"("}`,
+		`{"properties":{"a":1}}`,
+		`{"allOf":[]}`,
+		`{"$ref":"#/$defs/missing"}`,
+		`{"$ref":"#missing"}`,
+		`{"$ref":"https://example.com/schema"}`,
+		`{"$schema":"http://json-schema.org/draft-07/schema#"}`,
+		`{"unevaluatedProperties":false}`,
+		`{"items":{"$dynamicRef":"#a"}}`,
+		`{"items":{"$id":"https://example.com/a"}}`,
+		`{"multipleOf":0}`,
+	}
+	for _, s := range failCases {
+		schemaValue, err := ParseValue(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = CompileJSONSchema(schemaValue)
+		if err == nil {
+			t.Errorf("expected error on schema %s", s)
+		}
+	}
+}
+
+func TestJSONSchemaOutput(t *testing.T) {
+	schemaObject, err := ParseObject(`{
+		"$defs": {"point": {"type": "object", "required": ["x", "y"], "properties": {"x": {"type": "number"}}}},
+		"type": "object",
+		"properties": {
+			"points": {"type": "array", "items": {"$ref": "#/$defs/point"}},
+			"name": {"anyOf": [{"type": "string"}, {"type": "null"}]}
+		}
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := CompileJSONSchema(NewJSONObjectValue(schemaObject))
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance, err := ParseValue(`{"points":[{"x":1,"y":2},{"x":"1"}],"name":1}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := schema.Validate(instance)
+	result := output.ToJSONObject()
+	expected := `{"valid":false,"errors":[` +
+		`{"keywordLocation":"/properties/points/items/$ref/required","absoluteKeywordLocation":"#/$defs/point/required","instanceLocation":"/points/1","error":"missing required member \"y\""},` +
+		`{"keywordLocation":"/properties/points/items/$ref/properties/x/type","absoluteKeywordLocation":"#/$defs/point/properties/x/type","instanceLocation":"/points/1/x","error":"expected number but got string"},` +
+		`{"keywordLocation":"/properties/name/anyOf","instanceLocation":"/name","error":"value must match at least one subschema"},` +
+		`{"keywordLocation":"/properties/name/anyOf/0/type","instanceLocation":"/name","error":"expected string but got number"},` +
+		`{"keywordLocation":"/properties/name/anyOf/1/type","instanceLocation":"/name","error":"expected null but got number"}` +
+		`]}`
+	if got := result.String(MinimalStringCharacterEscapingBehavior); got != expected {
+		t.Errorf("unexpected output: %s", got)
+	}
+
+	valid := schema.Validate(NewJSONObjectValue(NewObject()))
+	if got := valid.ToJSONObject(); got.String(MinimalStringCharacterEscapingBehavior) != `{"valid":true}` {
+		t.Errorf("unexpected output: %s", got.String(MinimalStringCharacterEscapingBehavior))
+	}
+}
+
+type jsonSchemaTestCaseStruct struct {
+	schema   string
+	instance string
+	valid    bool
+}
@@ -0,0 +1,595 @@
+package json
+
+import (
+	"encoding"
+	stdjson "encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Encodes a Go value to JSON.
+// Values are converted with [FromAny] and encoded with ValueStruct.String().
+//
+// Exported struct fields are encoded as object members in field order.
+// The member name and options can be set with a "json" struct tag
+// with the same format as encoding/json:
+//
+//	Name  string `json:"name"`            // Member "name".
+//	Email string `json:"email,omitempty"` // Omitted if empty.
+//	ID    int64  `json:"id,string"`       // Encoded as a JSON string (e.g. "42").
+//	Token string `json:"-"`               // Ignored.
+//
+// Fields of embedded structs are promoted as if they were fields of the outer struct.
+// Values are empty if they are false, 0, a nil pointer or interface, or an empty array, slice, map, or string.
+//
+// Strings are escaped with MinimalStringCharacterEscapingBehavior.
+// Use [MarshalWithOptions] to set the escaping behavior.
+//
+// Returns an error if a value isn't supported, is a NaN or infinite number, or contains itself.
+func Marshal(v any) (string, error) {
+	return MarshalWithOptions(v, MarshalOptionsStruct{})
+}
+
+// Encodes a Go value to JSON like [Marshal].
+func MarshalWithOptions(v any, options MarshalOptionsStruct) (string, error) {
+	value, err := FromAny(v)
+	if err != nil {
+		return "", err
+	}
+	stringCharacterEscapingBehavior := MinimalStringCharacterEscapingBehavior
+	if options.StringCharacterEscapingBehavior != nil {
+		stringCharacterEscapingBehavior = options.StringCharacterEscapingBehavior
+	}
+	return value.String(stringCharacterEscapingBehavior), nil
+}
+
+type MarshalOptionsStruct struct {
+	// Defaults to MinimalStringCharacterEscapingBehavior if nil.
+	StringCharacterEscapingBehavior StringCharacterEscapingBehaviorInterface
+}
+
+type UnmarshalOptionsStruct struct {
+	// If true, object members without a matching struct field return an error wrapping [ErrUnknownField].
+	DisallowUnknownFields bool
+}
+
+// Parses a JSON value with ParseValue() and stores the result in the value pointed to by v.
+// Struct fields are matched by the names described in [Marshal],
+// preferring an exact match and falling back to a case-insensitive match.
+//
+// JSON numbers are decoded without loss of precision:
+// integers are rejected if they don't fit the target type
+// and *big.Int, *big.Float, *big.Rat, and encoding/json.Number are supported.
+// Empty interfaces are set with ValueStruct.ToAny() and AnyNumberFormatJSONNumber.
+// JSON null sets pointers, interfaces, maps, and slices to nil and leaves other values unchanged.
+// []byte values are decoded from standard base64 strings.
+// encoding/json.Unmarshaler and encoding.TextUnmarshaler implementations are used when available.
+//
+// Object members without a matching struct field are ignored.
+// Use [UnmarshalWithOptions] to reject them.
+//
+// Returns an error if v isn't a non-nil pointer,
+// the string is invalid JSON,
+// or a JSON value doesn't match the Go type.
+// Errors include the JSON Pointer of the value.
+func Unmarshal(s string, v any) error {
+	return UnmarshalWithOptions(s, v, UnmarshalOptionsStruct{})
+}
+
+// Parses a JSON value and stores the result in the value pointed to by v like [Unmarshal].
+func UnmarshalWithOptions(s string, v any, options UnmarshalOptionsStruct) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return fmt.Errorf("unsupported target %T", v)
+	}
+	parsed, err := ParseValue(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse value: %w", err)
+	}
+	return decodeValue(parsed, target.Elem(), "", options)
+}
+
+type structFieldStruct struct {
+	name      string
+	index     []int
+	omitEmpty bool
+	quoted    bool
+	tagged    bool
+	depth     int
+}
+
+var structFieldsCache sync.Map
+
+// Returns the encodable fields of a struct type, including promoted fields of embedded structs,
+// using the same precedence rules as encoding/json.
+func structFields(t reflect.Type) []structFieldStruct {
+	if cached, ok := structFieldsCache.Load(t); ok {
+		return cached.([]structFieldStruct)
+	}
+
+	candidates := []structFieldStruct{}
+	var walk func(t reflect.Type, index []int, depth int, visited map[reflect.Type]bool)
+	walk = func(t reflect.Type, index []int, depth int, visited map[reflect.Type]bool) {
+		visited[t] = true
+		defer delete(visited, t)
+		for i := range t.NumField() {
+			field := t.Field(i)
+			tag := field.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, tagOptions, _ := strings.Cut(tag, ",")
+			fieldIndex := append(append([]int{}, index...), i)
+			if field.Anonymous && name == "" {
+				embedded := field.Type
+				if embedded.Kind() == reflect.Pointer {
+					embedded = embedded.Elem()
+				}
+				if embedded.Kind() == reflect.Struct {
+					// Pointers to unexported struct types cannot be allocated.
+					if field.Type.Kind() == reflect.Pointer && !field.IsExported() {
+						continue
+					}
+					if !visited[embedded] {
+						walk(embedded, fieldIndex, depth+1, visited)
+					}
+					continue
+				}
+			}
+			if !field.IsExported() {
+				continue
+			}
+			candidate := structFieldStruct{
+				name:   name,
+				index:  fieldIndex,
+				tagged: name != "",
+				depth:  depth,
+			}
+			if name == "" {
+				candidate.name = field.Name
+			}
+			for option := range strings.SplitSeq(tagOptions, ",") {
+				switch option {
+				case "omitempty":
+					candidate.omitEmpty = true
+				case "string":
+					candidate.quoted = isQuotableType(field.Type)
+				}
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+	walk(t, nil, 0, map[reflect.Type]bool{})
+
+	// Fields at the shallowest depth win, and tagged fields win over untagged fields.
+	// Conflicting fields are ignored.
+	fields := []structFieldStruct{}
+	for _, candidate := range candidates {
+		dominant := true
+		for _, other := range candidates {
+			if other.name != candidate.name || sameIndex(other.index, candidate.index) {
+				continue
+			}
+			if other.depth < candidate.depth {
+				dominant = false
+				break
+			}
+			if other.depth == candidate.depth && (other.tagged || !candidate.tagged) {
+				dominant = false
+				break
+			}
+		}
+		if dominant {
+			fields = append(fields, candidate)
+		}
+	}
+
+	structFieldsCache.Store(t, fields)
+	return fields
+}
+
+func sameIndex(a []int, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// The "string" option only applies to strings, booleans, and numbers.
+func isQuotableType(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// Returns the field at index.
+// Nil embedded struct pointers are allocated if allocate is true, and otherwise return false.
+func fieldByIndex(value reflect.Value, index []int, allocate bool) (reflect.Value, bool) {
+	for i, fieldIndex := range index {
+		if i > 0 && value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				if !allocate {
+					return reflect.Value{}, false
+				}
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+			value = value.Elem()
+		}
+		value = value.Field(fieldIndex)
+	}
+	return value, true
+}
+
+func isEmptyValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return value.IsZero()
+	}
+	return false
+}
+
+func fromStruct(value reflect.Value, path string, visited map[fromAnyVisitStruct]struct{}) (ValueStruct, error) {
+	object := NewObject()
+	for _, field := range structFields(value.Type()) {
+		fieldValue, ok := fieldByIndex(value, field.index, false)
+		if !ok {
+			continue
+		}
+		if field.omitEmpty && isEmptyValue(fieldValue) {
+			continue
+		}
+		converted, err := fromAny(fieldValue, path+"/"+escapeJSONPointerToken(field.name), visited)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		if field.quoted && converted.kind != KindNull {
+			converted = NewStringValue(converted.String(MinimalStringCharacterEscapingBehavior))
+		}
+		object.Set(field.name, converted)
+	}
+	return NewJSONObjectValue(object), nil
+}
+
+var (
+	marshalerType       = reflect.TypeFor[stdjson.Marshaler]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	unmarshalerType     = reflect.TypeFor[stdjson.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// Returns false if the value doesn't implement encoding/json.Marshaler or encoding.TextMarshaler.
+func fromMarshaler(value reflect.Value, path string) (ValueStruct, bool, error) {
+	if value.Kind() != reflect.Pointer && value.Kind() != reflect.Interface && value.CanAddr() {
+		if reflect.PointerTo(value.Type()).Implements(marshalerType) || reflect.PointerTo(value.Type()).Implements(textMarshalerType) {
+			value = value.Addr()
+		}
+	}
+	if (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && value.IsNil() {
+		return ValueStruct{}, false, nil
+	}
+	if value.Type().Implements(marshalerType) {
+		encoded, err := value.Interface().(stdjson.Marshaler).MarshalJSON()
+		if err != nil {
+			return ValueStruct{}, true, fmt.Errorf("failed to marshal %s at %s: %w", value.Type(), strconv.Quote(path), err)
+		}
+		parsed, err := ParseValue(string(encoded))
+		if err != nil {
+			return ValueStruct{}, true, fmt.Errorf("failed to parse output of %s at %s: %w", value.Type(), strconv.Quote(path), err)
+		}
+		return parsed, true, nil
+	}
+	if value.Type().Implements(textMarshalerType) {
+		encoded, err := value.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return ValueStruct{}, true, fmt.Errorf("failed to marshal %s at %s: %w", value.Type(), strconv.Quote(path), err)
+		}
+		return NewStringValue(string(encoded)), true, nil
+	}
+	return ValueStruct{}, false, nil
+}
+
+func decodeTypeMismatchError(path string, expected Kind, actual Kind) error {
+	return fmt.Errorf("failed to decode value at %s: %w", strconv.Quote(path), &TypeMismatchErrorStruct{Expected: expected, Actual: actual})
+}
+
+func decodeValue(value ValueStruct, target reflect.Value, path string, options UnmarshalOptionsStruct) error {
+	switch target.Type() {
+	case valueStructType:
+		target.Set(reflect.ValueOf(value.Clone()))
+		return nil
+	case objectType:
+		if value.kind == KindNull {
+			return nil
+		}
+		if value.kind != KindObject {
+			return decodeTypeMismatchError(path, KindObject, value.kind)
+		}
+		target.Set(reflect.ValueOf(value.object.Clone()))
+		return nil
+	case arrayType:
+		if value.kind == KindNull {
+			return nil
+		}
+		if value.kind != KindArray {
+			return decodeTypeMismatchError(path, KindArray, value.kind)
+		}
+		target.Set(reflect.ValueOf(value.array.Clone()))
+		return nil
+	case jsonNumberType:
+		if value.kind == KindNull {
+			return nil
+		}
+		if value.kind != KindNumber {
+			return decodeTypeMismatchError(path, KindNumber, value.kind)
+		}
+		target.SetString(value.s)
+		return nil
+	case bigIntType, bigFloatType, ratType:
+		if value.kind == KindNull {
+			return nil
+		}
+		return decodeBigNumber(value, target.Addr(), path)
+	}
+
+	if target.Kind() == reflect.Pointer {
+		if value.kind == KindNull {
+			target.SetZero()
+			return nil
+		}
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return decodeValue(value, target.Elem(), path, options)
+	}
+
+	if target.CanAddr() {
+		pointer := target.Addr()
+		if pointer.Type().Implements(unmarshalerType) {
+			err := pointer.Interface().(stdjson.Unmarshaler).UnmarshalJSON([]byte(value.String(MinimalStringCharacterEscapingBehavior)))
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal %s at %s: %w", target.Type(), strconv.Quote(path), err)
+			}
+			return nil
+		}
+		if pointer.Type().Implements(textUnmarshalerType) && value.kind != KindNull {
+			if value.kind != KindString {
+				return decodeTypeMismatchError(path, KindString, value.kind)
+			}
+			err := pointer.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value.s))
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal %s at %s: %w", target.Type(), strconv.Quote(path), err)
+			}
+			return nil
+		}
+	}
+
+	if value.kind == KindNull {
+		switch target.Kind() {
+		case reflect.Interface, reflect.Map, reflect.Slice:
+			target.SetZero()
+		}
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Interface:
+		if target.NumMethod() != 0 {
+			return fmt.Errorf("unsupported type %s at %s", target.Type(), strconv.Quote(path))
+		}
+		converted, err := value.ToAny(AnyNumberFormatJSONNumber)
+		if err != nil {
+			return fmt.Errorf("failed to decode value at %s: %w", strconv.Quote(path), err)
+		}
+		target.Set(reflect.ValueOf(&converted).Elem())
+		return nil
+	case reflect.Bool:
+		if value.kind != KindBool {
+			return decodeTypeMismatchError(path, KindBool, value.kind)
+		}
+		target.SetBool(value.b)
+		return nil
+	case reflect.String:
+		if value.kind != KindString {
+			return decodeTypeMismatchError(path, KindString, value.kind)
+		}
+		target.SetString(value.s)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value.kind != KindNumber {
+			return decodeTypeMismatchError(path, KindNumber, value.kind)
+		}
+		parsed, err := parseSignedInteger(value.s, target.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("failed to decode value at %s: %w", strconv.Quote(path), err)
+		}
+		target.SetInt(parsed)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if value.kind != KindNumber {
+			return decodeTypeMismatchError(path, KindNumber, value.kind)
+		}
+		parsed, err := parseUnsignedInteger(value.s, target.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("failed to decode value at %s: %w", strconv.Quote(path), err)
+		}
+		target.SetUint(parsed)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if value.kind != KindNumber {
+			return decodeTypeMismatchError(path, KindNumber, value.kind)
+		}
+		parsed, err := parseFloat(value.s, target.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("failed to decode value at %s: %w", strconv.Quote(path), err)
+		}
+		target.SetFloat(parsed)
+		return nil
+	case reflect.Slice:
+		if target.Type().Elem().Kind() == reflect.Uint8 && value.kind == KindString {
+			decoded, err := decodeBytes(value.s, BytesEncodingBase64)
+			if err != nil {
+				return fmt.Errorf("failed to decode value at %s: %w", strconv.Quote(path), err)
+			}
+			target.SetBytes(decoded)
+			return nil
+		}
+		if value.kind != KindArray {
+			return decodeTypeMismatchError(path, KindArray, value.kind)
+		}
+		slice := reflect.MakeSlice(target.Type(), value.array.Length, value.array.Length)
+		for i := range value.array.Length {
+			element, _ := value.array.get(i)
+			err := decodeValue(element, slice.Index(i), path+"/"+strconv.Itoa(i), options)
+			if err != nil {
+				return err
+			}
+		}
+		target.Set(slice)
+		return nil
+	case reflect.Array:
+		if value.kind != KindArray {
+			return decodeTypeMismatchError(path, KindArray, value.kind)
+		}
+		for i := range target.Len() {
+			element, ok := value.array.get(i)
+			if !ok {
+				target.Index(i).SetZero()
+				continue
+			}
+			err := decodeValue(element, target.Index(i), path+"/"+strconv.Itoa(i), options)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		if target.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("unsupported map key type %s at %s", target.Type().Key(), strconv.Quote(path))
+		}
+		if value.kind != KindObject {
+			return decodeTypeMismatchError(path, KindObject, value.kind)
+		}
+		if target.IsNil() {
+			target.Set(reflect.MakeMapWithSize(target.Type(), len(value.object.Keys)))
+		}
+		for _, key := range value.object.Keys {
+			member, _ := value.object.get(key)
+			element := reflect.New(target.Type().Elem()).Elem()
+			err := decodeValue(member, element, path+"/"+escapeJSONPointerToken(key), options)
+			if err != nil {
+				return err
+			}
+			target.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), element)
+		}
+		return nil
+	case reflect.Struct:
+		if value.kind != KindObject {
+			return decodeTypeMismatchError(path, KindObject, value.kind)
+		}
+		return decodeStruct(value.object, target, path, options)
+	}
+	return fmt.Errorf("unsupported type %s at %s", target.Type(), strconv.Quote(path))
+}
+
+func decodeStruct(object ObjectStruct, target reflect.Value, path string, options UnmarshalOptionsStruct) error {
+	fields := structFields(target.Type())
+	for _, key := range object.Keys {
+		memberPath := path + "/" + escapeJSONPointerToken(key)
+		field, ok := findStructField(fields, key)
+		if !ok {
+			if options.DisallowUnknownFields {
+				return fmt.Errorf("member %s at %s: %w", strconv.Quote(key), strconv.Quote(path), ErrUnknownField)
+			}
+			continue
+		}
+		fieldValue, _ := fieldByIndex(target, field.index, true)
+		if !fieldValue.CanSet() {
+			return fmt.Errorf("cannot set field for member %s at %s", strconv.Quote(key), strconv.Quote(path))
+		}
+		member, _ := object.get(key)
+		if field.quoted && member.kind != KindNull {
+			if member.kind != KindString {
+				return decodeTypeMismatchError(memberPath, KindString, member.kind)
+			}
+			unquoted, err := ParseValue(member.s)
+			if err != nil || unquoted.kind == KindObject || unquoted.kind == KindArray {
+				return fmt.Errorf("invalid quoted value %s at %s", strconv.Quote(member.s), strconv.Quote(memberPath))
+			}
+			member = unquoted
+		}
+		err := decodeValue(member, fieldValue, memberPath, options)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Prefers an exact match over a case-insensitive match.
+func findStructField(fields []structFieldStruct, key string) (structFieldStruct, bool) {
+	for _, field := range fields {
+		if field.name == key {
+			return field, true
+		}
+	}
+	for _, field := range fields {
+		if strings.EqualFold(field.name, key) {
+			return field, true
+		}
+	}
+	return structFieldStruct{}, false
+}
+
+func decodeBigNumber(value ValueStruct, target reflect.Value, path string) error {
+	if value.kind != KindNumber {
+		return decodeTypeMismatchError(path, KindNumber, value.kind)
+	}
+	var err error
+	switch target := target.Interface().(type) {
+	case *big.Int:
+		var parsed *big.Int
+		parsed, err = parseBigInt(value.s)
+		if err == nil {
+			target.Set(parsed)
+		}
+	case *big.Float:
+		var parsed *big.Float
+		parsed, err = parseBigFloat(value.s)
+		if err == nil {
+			target.Set(parsed)
+		}
+	case *big.Rat:
+		var parsed *big.Rat
+		parsed, err = parseRat(value.s)
+		if err == nil {
+			target.Set(parsed)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode value at %s: %w", strconv.Quote(path), err)
+	}
+	return nil
+}
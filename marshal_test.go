@@ -0,0 +1,188 @@
+package json
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type marshalBaseStruct struct {
+	ID      int64 `json:"id,string"`
+	Created time.Time
+}
+
+type marshalUserStruct struct {
+	marshalBaseStruct
+	Name     string             `json:"name"`
+	Email    string             `json:"email,omitempty"`
+	Tags     []string           `json:"tags"`
+	Labels   map[string]string  `json:"labels,omitempty"`
+	Manager  *marshalUserStruct `json:"manager,omitempty"`
+	Balance  *big.Rat           `json:"balance"`
+	Metadata ObjectStruct       `json:"metadata"`
+	Password string             `json:"-"`
+	internal string
+}
+
+func TestMarshal(t *testing.T) {
+	metadata := NewObject()
+	metadata.SetBool("admin", true)
+	user := marshalUserStruct{
+		marshalBaseStruct: marshalBaseStruct{ID: 42, Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		Name:              "Alice",
+		Tags:              []string{"a"},
+		Manager:           &marshalUserStruct{Name: "Bob", Balance: big.NewRat(0, 1)},
+		Balance:           big.NewRat(5, 4),
+		Metadata:          metadata,
+		Password:          "secret",
+		internal:          "x",
+	}
+	encoded, err := Marshal(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"id":"42","Created":"2024-01-02T03:04:05Z","name":"Alice","tags":["a"],"manager":{"id":"0","Created":"0001-01-01T00:00:00Z","name":"Bob","tags":null,"balance":0,"metadata":{}},"balance":1.25,"metadata":{"admin":true}}`
+	if encoded != expected {
+		t.Errorf("unexpected output: %s", encoded)
+	}
+
+	var decoded marshalUserStruct
+	err = Unmarshal(encoded, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reencoded, err := MarshalWithOptions(decoded, MarshalOptionsStruct{StringCharacterEscapingBehavior: MinimalStringCharacterEscapingBehavior})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reencoded != expected {
+		t.Errorf("unexpected output after round trip: %s", reencoded)
+	}
+	if decoded.ID != 42 || !decoded.Created.Equal(user.Created) || decoded.Manager.Name != "Bob" {
+		t.Errorf("unexpected decoded value: %#v", decoded)
+	}
+}
+
+func TestMarshalEmbeddedConflicts(t *testing.T) {
+	type a struct {
+		X int
+		Y int `json:"y"`
+	}
+	type outer struct {
+		a
+		Z int `json:"X"`
+	}
+	encoded, err := Marshal(outer{a: a{X: 1, Y: 2}, Z: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encoded != `{"y":2,"X":3}` {
+		t.Errorf("unexpected output: %s", encoded)
+	}
+
+	var decoded outer
+	err = Unmarshal(`{"X":4,"Y":5,"y":6}`, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Z != 4 || decoded.X != 0 || decoded.Y != 6 {
+		t.Errorf("unexpected decoded value: %#v", decoded)
+	}
+}
+
+func TestMarshalCycle(t *testing.T) {
+	user := &marshalUserStruct{Name: "Alice"}
+	user.Manager = user
+	_, err := Marshal(user)
+	if err == nil {
+		t.Error("expected error on cyclic value")
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	var numbers struct {
+		Int   int64
+		Uint  uint8
+		Float float64
+		Big   *big.Int
+		Any   any
+		Bytes []byte
+		Array [2]int
+	}
+	err := Unmarshal(`{"int":-9223372036854775808,"Uint":255,"Float":0.5,"Big":123456789012345678901234567890,"Any":[1.50,{"a":null}],"Bytes":"aGk=","Array":[1]}`, &numbers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if numbers.Int != -9223372036854775808 || numbers.Uint != 255 || numbers.Float != 0.5 || numbers.Big.String() != "123456789012345678901234567890" {
+		t.Errorf("unexpected decoded value: %#v", numbers)
+	}
+	anyValue, err := FromAny(numbers.Any)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := anyValue.String(MinimalStringCharacterEscapingBehavior); got != `[1.50,{"a":null}]` {
+		t.Errorf("unexpected any value: %s", got)
+	}
+	if string(numbers.Bytes) != "hi" || numbers.Array != [2]int{1, 0} {
+		t.Errorf("unexpected decoded value: %#v", numbers)
+	}
+
+	failCases := []unmarshalTestCaseStruct{
+		{`{"Uint":256}`, ErrOutOfRange},
+		{`{"Int":1.5}`, ErrFractionalNumber},
+		{`{"Uint":-1}`, ErrNegativeNumber},
+		{`{"Float":"1"}`, ErrTypeMismatch},
+		{`{"Array":[1,"a"]}`, ErrTypeMismatch},
+		{`{"Unknown":1}`, ErrUnknownField},
+	}
+	for _, c := range failCases {
+		err := UnmarshalWithOptions(c.s, &numbers, UnmarshalOptionsStruct{DisallowUnknownFields: true})
+		if !errors.Is(err, c.expected) {
+			t.Errorf("unexpected error on input %s: %v", c.s, err)
+		}
+	}
+
+	err = Unmarshal(`{"Unknown":1}`, &numbers)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	err = Unmarshal(`{}`, numbers)
+	if err == nil {
+		t.Error("expected error on non-pointer target")
+	}
+}
+
+func TestUnmarshalNull(t *testing.T) {
+	value := struct {
+		Pointer *int
+		Slice   []int
+		Int     int
+	}{new(int), []int{1}, 1}
+	err := Unmarshal(`{"Pointer":null,"Slice":null,"Int":null}`, &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Pointer != nil || value.Slice != nil || value.Int != 1 {
+		t.Errorf("unexpected decoded value: %#v", value)
+	}
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	var value map[string][]*int
+	err := Unmarshal(`{"a":[1,null],"b/c":[]}`, &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	one := 1
+	expected := map[string][]*int{"a": {&one, nil}, "b/c": {}}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("unexpected decoded value: %#v", value)
+	}
+}
+
+type unmarshalTestCaseStruct struct {
+	s        string
+	expected error
+}
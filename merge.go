@@ -0,0 +1,166 @@
+package json
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// How [Merge] combines two arrays.
+type ArrayMergeStrategy int
+
+const (
+	// The overlay array replaces the base array.
+	ArrayMergeReplace ArrayMergeStrategy = iota
+	// The overlay elements are appended to the base elements.
+	ArrayMergeAppend
+	// Elements at the same index are merged.
+	// Elements only present in the longer array are kept.
+	ArrayMergeByIndex
+	// Object elements with equal values for MergeOptionsStruct.ArrayMergeKey are merged.
+	// Other overlay elements are appended.
+	ArrayMergeByKey
+)
+
+type MergeOptionsStruct struct {
+	ArrayMergeStrategy ArrayMergeStrategy
+	// The member name used to match object elements with ArrayMergeByKey.
+	ArrayMergeKey string
+	// If true, null overlay members remove the member from the result.
+	NullDeletes bool
+	// Called when the base and overlay values at the same path have different types,
+	// where path is a JSON Pointer. The returned value is used in the result.
+	// Returning an error aborts the merge.
+	// If nil, the overlay value is used.
+	OnConflict func(path string, base ValueStruct, overlay ValueStruct) (ValueStruct, error)
+}
+
+// Returns a deep merge of overlay on top of base.
+// Nested objects are merged recursively, arrays are combined according to the array merge strategy,
+// and any other overlay value replaces the base value.
+//
+// Neither base nor overlay is modified.
+func Merge(base ObjectStruct, overlay ObjectStruct, options MergeOptionsStruct) (ObjectStruct, error) {
+	return mergeObjects(base, overlay, options, "")
+}
+
+func mergeObjects(base ObjectStruct, overlay ObjectStruct, options MergeOptionsStruct, path string) (ObjectStruct, error) {
+	result := base.Clone()
+	for _, key := range overlay.Keys {
+		overlayValue, _ := overlay.get(key)
+		if options.NullDeletes && overlayValue.kind == KindNull {
+			result.Delete(key)
+			continue
+		}
+		baseValue, ok := base.get(key)
+		if !ok {
+			result.Set(key, overlayValue.Clone())
+			continue
+		}
+		merged, err := mergeValues(baseValue, overlayValue, options, path+"/"+escapeJSONPointerToken(key))
+		if err != nil {
+			return ObjectStruct{}, err
+		}
+		result.Set(key, merged)
+	}
+	return result, nil
+}
+
+func mergeValues(base ValueStruct, overlay ValueStruct, options MergeOptionsStruct, path string) (ValueStruct, error) {
+	if base.kind != overlay.kind {
+		if options.OnConflict == nil {
+			return overlay.Clone(), nil
+		}
+		resolved, err := options.OnConflict(path, base, overlay)
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("conflict at %s: %w", path, err)
+		}
+		return resolved.Clone(), nil
+	}
+	switch base.kind {
+	case KindObject:
+		merged, err := mergeObjects(base.object, overlay.object, options, path)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		return NewJSONObjectValue(merged), nil
+	case KindArray:
+		merged, err := mergeArrays(base.array, overlay.array, options, path)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		return NewJSONArrayValue(merged), nil
+	}
+	return overlay.Clone(), nil
+}
+
+func mergeArrays(base ArrayStruct, overlay ArrayStruct, options MergeOptionsStruct, path string) (ArrayStruct, error) {
+	switch options.ArrayMergeStrategy {
+	case ArrayMergeAppend:
+		result := base.Clone()
+		for i := range overlay.Length {
+			value, _ := overlay.get(i)
+			result.Add(value.Clone())
+		}
+		return result, nil
+	case ArrayMergeByIndex:
+		result := NewArray()
+		for i := range max(base.Length, overlay.Length) {
+			baseValue, baseOk := base.get(i)
+			overlayValue, overlayOk := overlay.get(i)
+			if !overlayOk {
+				result.Add(baseValue.Clone())
+				continue
+			}
+			if !baseOk {
+				result.Add(overlayValue.Clone())
+				continue
+			}
+			merged, err := mergeValues(baseValue, overlayValue, options, path+"/"+strconv.Itoa(i))
+			if err != nil {
+				return ArrayStruct{}, err
+			}
+			result.Add(merged)
+		}
+		return result, nil
+	case ArrayMergeByKey:
+		result := base.Clone()
+		for i := range overlay.Length {
+			overlayValue, _ := overlay.get(i)
+			index, ok := findArrayMergeKeyMatch(result, overlayValue, options.ArrayMergeKey)
+			if !ok {
+				result.Add(overlayValue.Clone())
+				continue
+			}
+			baseValue, _ := result.get(index)
+			merged, err := mergeValues(baseValue, overlayValue, options, path+"/"+strconv.Itoa(index))
+			if err != nil {
+				return ArrayStruct{}, err
+			}
+			result.Set(index, merged)
+		}
+		return result, nil
+	}
+	return overlay.Clone(), nil
+}
+
+// Returns the index of the first object element in array with the same key member value as value.
+func findArrayMergeKeyMatch(array ArrayStruct, value ValueStruct, key string) (int, bool) {
+	if value.kind != KindObject {
+		return 0, false
+	}
+	keyValue, ok := value.object.get(key)
+	if !ok {
+		return 0, false
+	}
+	for i := range array.Length {
+		element, _ := array.get(i)
+		if element.kind != KindObject {
+			continue
+		}
+		elementKeyValue, ok := element.object.get(key)
+		if ok && Equal(elementKeyValue, keyValue, EqualityOptionsStruct{CompareNumbersByValue: true}) {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,96 @@
+package json
+
+import (
+	"fmt"
+)
+
+// Applies a JSON Merge Patch (RFC 7386) and returns the patched value.
+// Members of the patch with a null value are removed from the target,
+// and any patch that isn't an object replaces the target.
+//
+// Neither the target nor the patch is modified.
+func MergePatch(target ValueStruct, patch ValueStruct) ValueStruct {
+	return mergePatch(target.Clone(), patch)
+}
+
+// The target is modified in place.
+func mergePatch(target ValueStruct, patch ValueStruct) ValueStruct {
+	if patch.kind != KindObject {
+		return patch.Clone()
+	}
+	if target.kind != KindObject {
+		target = NewJSONObjectValue(NewObject())
+	}
+	for _, key := range patch.object.Keys {
+		value, _ := patch.object.get(key)
+		if value.kind == KindNull {
+			target.object.Delete(key)
+			continue
+		}
+		current, ok := target.object.get(key)
+		if !ok {
+			current = NewNullValue()
+		}
+		target.object.Set(key, mergePatch(current, value))
+	}
+	return target
+}
+
+// Returns the smallest JSON Merge Patch (RFC 7386) that turns original into modified.
+// Numbers are compared by their lexeme.
+//
+// Returns an error if modified adds or changes a null value,
+// since merge patches use null to remove members and cannot set a member to null.
+func CreateMergePatch(original ObjectStruct, modified ObjectStruct) (ObjectStruct, error) {
+	return createMergePatch(original, modified, "")
+}
+
+func createMergePatch(original ObjectStruct, modified ObjectStruct, path string) (ObjectStruct, error) {
+	patch := NewObject()
+	for _, key := range original.Keys {
+		if !modified.Has(key) {
+			patch.SetNull(key)
+		}
+	}
+	for _, key := range modified.Keys {
+		memberPath := path + "/" + escapeJSONPointerToken(key)
+		modifiedValue, _ := modified.get(key)
+		originalValue, ok := original.get(key)
+		if ok && originalValue.kind == KindObject && modifiedValue.kind == KindObject {
+			nested, err := createMergePatch(originalValue.object, modifiedValue.object, memberPath)
+			if err != nil {
+				return ObjectStruct{}, err
+			}
+			if len(nested.Keys) > 0 {
+				patch.SetJSONObject(key, nested)
+			}
+			continue
+		}
+		if ok && Equal(originalValue, modifiedValue, EqualityOptionsStruct{}) {
+			continue
+		}
+		if nullPath, found := findMergePatchNull(modifiedValue, memberPath); found {
+			return ObjectStruct{}, fmt.Errorf("cannot set null at %s", nullPath)
+		}
+		patch.Set(key, modifiedValue.Clone())
+	}
+	return patch, nil
+}
+
+// Returns the path of a null value that would be interpreted as a removal if value is used in a merge patch.
+// Nulls inside arrays are kept as is by merge patches.
+func findMergePatchNull(value ValueStruct, path string) (string, bool) {
+	if value.kind == KindNull {
+		return path, true
+	}
+	if value.kind != KindObject {
+		return "", false
+	}
+	for _, key := range value.object.Keys {
+		member, _ := value.object.get(key)
+		if nullPath, found := findMergePatchNull(member, path+"/"+escapeJSONPointerToken(key)); found {
+			return nullPath, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,116 @@
+package json
+
+import (
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	// RFC 7386 Appendix A.
+	testCases := []mergePatchTestCaseStruct{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, c := range testCases {
+		target := parseMergePatchTestValue(t, c.target)
+		patch := parseMergePatchTestValue(t, c.patch)
+		result := MergePatch(target, patch)
+		if got := result.String(MinimalStringCharacterEscapingBehavior); got != c.expected {
+			t.Errorf("unexpected output on target %s and patch %s: %s", c.target, c.patch, got)
+		}
+		if target.String(MinimalStringCharacterEscapingBehavior) != c.target {
+			t.Errorf("target modified on target %s and patch %s", c.target, c.patch)
+		}
+	}
+}
+
+func TestCreateMergePatch(t *testing.T) {
+	successCases := []createMergePatchTestCaseStruct{
+		{`{"a":1,"b":2}`, `{"a":1,"b":2}`, `{}`},
+		{`{"a":1,"b":2}`, `{"a":3,"c":4}`, `{"b":null,"a":3,"c":4}`},
+		{`{"a":{"b":1,"c":2}}`, `{"a":{"b":1,"c":3}}`, `{"a":{"c":3}}`},
+		{`{"a":{"b":1}}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`{"a":[1,2]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`{"a":null}`, `{"a":null,"b":[null]}`, `{"b":[null]}`},
+		{`{"a":null}`, `{}`, `{"a":null}`},
+	}
+	for _, c := range successCases {
+		original, err := ParseObject(c.original)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.original, err)
+		}
+		modified, err := ParseObject(c.modified)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.modified, err)
+		}
+		patch, err := CreateMergePatch(original, modified)
+		if err != nil {
+			t.Errorf("error on input %s: %s", c.modified, err)
+			continue
+		}
+		if got := patch.String(MinimalStringCharacterEscapingBehavior); got != c.expected {
+			t.Errorf("unexpected patch on input %s: %s", c.modified, got)
+		}
+		result := MergePatch(NewJSONObjectValue(original), NewJSONObjectValue(patch))
+		if !Equal(result, NewJSONObjectValue(modified), EqualityOptionsStruct{}) {
+			t.Errorf("unexpected output after applying patch on input %s: %s", c.modified, result.String(MinimalStringCharacterEscapingBehavior))
+		}
+	}
+
+	failCases := []createMergePatchTestCaseStruct{
+		{`{"a":1}`, `{"a":null}`, ""},
+		{`{}`, `{"a":null}`, ""},
+		{`{"a":{"b":1}}`, `{"a":{"b":1,"c":{"d":null}}}`, ""},
+	}
+	for _, c := range failCases {
+		original, err := ParseObject(c.original)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.original, err)
+		}
+		modified, err := ParseObject(c.modified)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.modified, err)
+		}
+		_, err = CreateMergePatch(original, modified)
+		if err == nil {
+			t.Errorf("expected error on input %s", c.modified)
+		}
+	}
+}
+
+// Parses any JSON value by wrapping it in an array.
+func parseMergePatchTestValue(t *testing.T, s string) ValueStruct {
+	array, err := ParseArray("[" + s + "]")
+	if err != nil {
+		t.Fatalf("failed to parse %s: %s", s, err)
+	}
+	value, err := array.Get(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+type mergePatchTestCaseStruct struct {
+	target   string
+	patch    string
+	expected string
+}
+
+type createMergePatchTestCaseStruct struct {
+	original string
+	modified string
+	expected string
+}
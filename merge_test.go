@@ -0,0 +1,81 @@
+package json
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	successCases := []mergeTestCaseStruct{
+		{`{"a":1,"b":{"c":1,"d":2}}`, `{"b":{"d":3,"e":4},"f":5}`, MergeOptionsStruct{}, `{"a":1,"b":{"c":1,"d":3,"e":4},"f":5}`},
+		{`{"a":1,"b":2}`, `{"a":null}`, MergeOptionsStruct{}, `{"a":null,"b":2}`},
+		{`{"a":1,"b":2}`, `{"a":null,"c":null}`, MergeOptionsStruct{NullDeletes: true}, `{"b":2}`},
+		{`{"a":[1,2]}`, `{"a":[3]}`, MergeOptionsStruct{ArrayMergeStrategy: ArrayMergeReplace}, `{"a":[3]}`},
+		{`{"a":[1,2]}`, `{"a":[3]}`, MergeOptionsStruct{ArrayMergeStrategy: ArrayMergeAppend}, `{"a":[1,2,3]}`},
+		{`{"a":[{"x":1},2]}`, `{"a":[{"y":2},3,4]}`, MergeOptionsStruct{ArrayMergeStrategy: ArrayMergeByIndex}, `{"a":[{"x":1,"y":2},3,4]}`},
+		{`{"a":[{"id":1,"x":1},{"id":2,"x":2}]}`, `{"a":[{"id":2,"x":3},{"id":3,"x":4},5]}`, MergeOptionsStruct{ArrayMergeStrategy: ArrayMergeByKey, ArrayMergeKey: "id"}, `{"a":[{"id":1,"x":1},{"id":2,"x":3},{"id":3,"x":4},5]}`},
+		{`{"a":{"b":1}}`, `{"a":"x"}`, MergeOptionsStruct{}, `{"a":"x"}`},
+	}
+	for _, c := range successCases {
+		base, err := ParseObject(c.base)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.base, err)
+		}
+		overlay, err := ParseObject(c.overlay)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.overlay, err)
+		}
+		result, err := Merge(base, overlay, c.options)
+		if err != nil {
+			t.Errorf("error on input %s: %s", c.overlay, err)
+			continue
+		}
+		got := result.String(MinimalStringCharacterEscapingBehavior)
+		if got != c.expected {
+			t.Errorf("unexpected output on input %s: %s", c.overlay, got)
+		}
+		if base.String(MinimalStringCharacterEscapingBehavior) != c.base {
+			t.Errorf("base modified on input %s", c.overlay)
+		}
+	}
+}
+
+func TestMergeConflict(t *testing.T) {
+	base, _ := ParseObject(`{"a":{"b":[{"c":1}]}}`)
+	overlay, _ := ParseObject(`{"a":{"b":[{"c":"x"}]}}`)
+	errConflict := errors.New("conflict")
+	var conflictPath string
+	_, err := Merge(base, overlay, MergeOptionsStruct{
+		ArrayMergeStrategy: ArrayMergeByIndex,
+		OnConflict: func(path string, base ValueStruct, overlay ValueStruct) (ValueStruct, error) {
+			conflictPath = path
+			return ValueStruct{}, errConflict
+		},
+	})
+	if !errors.Is(err, errConflict) {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+	if conflictPath != "/a/b/0/c" {
+		t.Errorf("unexpected conflict path: %s", conflictPath)
+	}
+
+	result, err := Merge(base, overlay, MergeOptionsStruct{
+		ArrayMergeStrategy: ArrayMergeByIndex,
+		OnConflict: func(path string, base ValueStruct, overlay ValueStruct) (ValueStruct, error) {
+			return base, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := result.String(MinimalStringCharacterEscapingBehavior); got != `{"a":{"b":[{"c":1}]}}` {
+		t.Errorf("unexpected output: %s", got)
+	}
+}
+
+type mergeTestCaseStruct struct {
+	base     string
+	overlay  string
+	options  MergeOptionsStruct
+	expected string
+}
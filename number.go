@@ -0,0 +1,363 @@
+package json
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// A JSON number decomposed into its significant digits and a decimal exponent.
+// The number is digits * 10^exponent.
+type decimalStruct struct {
+	negative bool
+	// No leading zeros and no trailing zeros, unless removing them would overflow the exponent.
+	// Empty for zero.
+	digits   string
+	exponent int64
+}
+
+// Parses a JSON number lexeme without any loss of precision.
+func parseDecimal(lexeme string) (decimalStruct, error) {
+	s := lexeme
+	negative := false
+	if strings.HasPrefix(s, "-") {
+		negative = true
+		s = s[1:]
+	}
+
+	var exponent int64 = 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		parsed, err := strconv.ParseInt(strings.TrimPrefix(s[i+1:], "+"), 10, 64)
+		if err != nil {
+			return decimalStruct{}, fmt.Errorf("failed to parse exponent: %s", err.Error())
+		}
+		exponent = parsed
+		s = s[:i]
+	}
+
+	integerPart, fractionPart, _ := strings.Cut(s, ".")
+	if integerPart == "" {
+		return decimalStruct{}, fmt.Errorf("invalid number")
+	}
+	digits := integerPart + fractionPart
+	for _, char := range digits {
+		if !isDigitCharacter(char) {
+			return decimalStruct{}, fmt.Errorf("invalid number")
+		}
+	}
+	// Saturates for numbers smaller than 1e-9223372036854775808.
+	if exponent < math.MinInt64+int64(len(fractionPart)) {
+		exponent = math.MinInt64
+	} else {
+		exponent -= int64(len(fractionPart))
+	}
+
+	digits = strings.TrimLeft(digits, "0")
+	trailingZeros := int64(len(digits) - len(strings.TrimRight(digits, "0")))
+	if exponent > math.MaxInt64-trailingZeros {
+		trailingZeros = math.MaxInt64 - exponent
+	}
+	digits = digits[:len(digits)-int(trailingZeros)]
+	exponent += trailingZeros
+
+	if digits == "" {
+		return decimalStruct{}, nil
+	}
+	return decimalStruct{negative: negative, digits: digits, exponent: exponent}, nil
+}
+
+func (decimal decimalStruct) isZero() bool {
+	return decimal.digits == ""
+}
+
+func (decimal decimalStruct) isInteger() bool {
+	return decimal.exponent >= 0
+}
+
+// Returns -1, 0, or 1.
+func compareDecimals(a decimalStruct, b decimalStruct) int {
+	if a.isZero() && b.isZero() {
+		return 0
+	}
+	if a.negative != b.negative || a.isZero() || b.isZero() {
+		return decimalSign(a) - decimalSign(b)
+	}
+	result := compareDecimalMagnitudes(a, b)
+	if a.negative {
+		return -result
+	}
+	return result
+}
+
+func decimalSign(decimal decimalStruct) int {
+	if decimal.isZero() {
+		return 0
+	}
+	if decimal.negative {
+		return -1
+	}
+	return 1
+}
+
+func compareDecimalMagnitudes(a decimalStruct, b decimalStruct) int {
+	// The position of the most significant digit.
+	// Computed with big.Int since it overflows int64 for exponents near the limits.
+	aMagnitude := new(big.Int).Add(big.NewInt(a.exponent), big.NewInt(int64(len(a.digits))))
+	bMagnitude := new(big.Int).Add(big.NewInt(b.exponent), big.NewInt(int64(len(b.digits))))
+	if result := aMagnitude.Cmp(bMagnitude); result != 0 {
+		return result
+	}
+	return strings.Compare(strings.TrimRight(a.digits, "0"), strings.TrimRight(b.digits, "0"))
+}
+
+// Returns -1, 0, or 1 by comparing the numeric values of two JSON number lexemes.
+// Falls back to comparing the lexemes if either can't be parsed.
+func compareNumbers(a string, b string) int {
+	aDecimal, err := parseDecimal(a)
+	if err != nil {
+		return strings.Compare(a, b)
+	}
+	bDecimal, err := parseDecimal(b)
+	if err != nil {
+		return strings.Compare(a, b)
+	}
+	return compareDecimals(aDecimal, bDecimal)
+}
+
+// Formats a float as a JSON number using the shortest representation that round-trips.
+// Exponent notation is only used for very small and very large values.
+// Returns an error if the value is NaN or infinite.
+func formatFloat(value float64, bitSize int) (string, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return "", fmt.Errorf("unsupported value %v", value)
+	}
+	format := byte('f')
+	abs := math.Abs(value)
+	if abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) || bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	b := strconv.AppendFloat(nil, value, format, -1, bitSize)
+	if format == 'e' {
+		// Shorten e-09 to e-9.
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return string(b), nil
+}
+
+// Parses an integer lexeme as an unsigned integer that fits in bitSize bits.
+// Integer lexemes don't have a fractional part or an exponent (e.g. 1.0 and 1e3 are rejected).
+// Returns an error wrapping [ErrNegativeNumber], [ErrFractionalNumber], or [ErrOutOfRange] if the number is
+// negative, has a fractional part, or doesn't fit.
+func parseUnsignedInteger(lexeme string, bitSize int) (uint64, error) {
+	if lexeme == "-0" {
+		return 0, nil
+	}
+	parsed, err := strconv.ParseUint(lexeme, 10, bitSize)
+	if err == nil {
+		return parsed, nil
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("value %s %w", lexeme, ErrOutOfRange)
+	}
+	decimal, err := parseDecimal(lexeme)
+	if err == nil && decimal.negative && !decimal.isZero() {
+		return 0, fmt.Errorf("%w %s", ErrNegativeNumber, lexeme)
+	}
+	return 0, integerLexemeError(lexeme)
+}
+
+// Parses an integer lexeme as a signed integer that fits in bitSize bits.
+// Returns an error wrapping [ErrFractionalNumber] or [ErrOutOfRange] if the number
+// has a fractional part or doesn't fit.
+func parseSignedInteger(lexeme string, bitSize int) (int64, error) {
+	parsed, err := strconv.ParseInt(lexeme, 10, bitSize)
+	if err == nil {
+		return parsed, nil
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("value %s %w", lexeme, ErrOutOfRange)
+	}
+	return 0, integerLexemeError(lexeme)
+}
+
+// Returns an error wrapping [ErrFractionalNumber] if the number has a fractional part.
+func integerLexemeError(lexeme string) error {
+	decimal, err := parseDecimal(lexeme)
+	if err == nil && !decimal.isInteger() {
+		return fmt.Errorf("%w %s", ErrFractionalNumber, lexeme)
+	}
+	return fmt.Errorf("invalid integer %s", lexeme)
+}
+
+// Parses a JSON number lexeme as a float that fits in bitSize bits.
+// Returns an error wrapping [ErrOutOfRange] if the number is too large.
+func parseFloat(lexeme string, bitSize int) (float64, error) {
+	parsed, err := strconv.ParseFloat(lexeme, bitSize)
+	if err != nil && errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("value %s %w", lexeme, ErrOutOfRange)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %s", lexeme)
+	}
+	return parsed, nil
+}
+
+// Limits the size of numbers converted to math/big types,
+// since a short lexeme like 1e999999999 would otherwise require a huge allocation.
+const maxBigNumberExponent = 100000
+
+func checkBigNumberExponent(decimal decimalStruct, lexeme string) error {
+	if decimal.exponent > maxBigNumberExponent || decimal.exponent < -maxBigNumberExponent {
+		return fmt.Errorf("value %s %w", lexeme, ErrOutOfRange)
+	}
+	return nil
+}
+
+// Parses an integer lexeme as an arbitrary precision integer.
+// Returns an error wrapping [ErrFractionalNumber] if the number has a fractional part.
+func parseBigInt(lexeme string) (*big.Int, error) {
+	if !isIntegerLexeme(lexeme) {
+		return nil, integerLexemeError(lexeme)
+	}
+	parsed, ok := new(big.Int).SetString(lexeme, 10)
+	if !ok {
+		return nil, integerLexemeError(lexeme)
+	}
+	return parsed, nil
+}
+
+func isIntegerLexeme(lexeme string) bool {
+	digits := strings.TrimPrefix(lexeme, "-")
+	if digits == "" {
+		return false
+	}
+	for _, char := range digits {
+		if !isDigitCharacter(char) {
+			return false
+		}
+	}
+	return true
+}
+
+// Parses a JSON number lexeme as an exact rational number.
+func parseRat(lexeme string) (*big.Rat, error) {
+	decimal, err := parseDecimal(lexeme)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse number: %s", err.Error())
+	}
+	err = checkBigNumberExponent(decimal, lexeme)
+	if err != nil {
+		return nil, err
+	}
+	parsed, ok := new(big.Rat).SetString(lexeme)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %s", lexeme)
+	}
+	return parsed, nil
+}
+
+// Parses a JSON number lexeme as a binary floating-point number.
+// The precision is large enough to hold every significant decimal digit of the lexeme,
+// with a minimum of 64 bits.
+func parseBigFloat(lexeme string) (*big.Float, error) {
+	decimal, err := parseDecimal(lexeme)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse number: %s", err.Error())
+	}
+	err = checkBigNumberExponent(decimal, lexeme)
+	if err != nil {
+		return nil, err
+	}
+	precision := max(uint(math.Ceil(float64(len(decimal.digits))*math.Log2(10))), 64)
+	parsed, _, err := big.ParseFloat(lexeme, 10, precision, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse float: %s", err.Error())
+	}
+	return parsed, nil
+}
+
+// Formats a big.Float as a JSON number using the shortest decimal representation
+// that round-trips at the value's precision.
+// Returns an error if the value is infinite.
+func formatBigFloat(value *big.Float) (string, error) {
+	if value.IsInf() {
+		return "", fmt.Errorf("unsupported value %s", value.String())
+	}
+	return value.Text('g', -1), nil
+}
+
+// Formats a big.Rat as an exact JSON number.
+// Returns an error if the value has no finite decimal representation (e.g. 1/3).
+func formatRat(value *big.Rat) (string, error) {
+	if value.IsInt() {
+		return value.Num().String(), nil
+	}
+	denominator := new(big.Int).Set(value.Denom())
+	twos := denominator.TrailingZeroBits()
+	denominator.Rsh(denominator, twos)
+	fives := uint(0)
+	five := big.NewInt(5)
+	remainder := new(big.Int)
+	for {
+		quotient, _ := new(big.Int).QuoRem(denominator, five, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+		denominator = quotient
+		fives++
+	}
+	if denominator.Cmp(big.NewInt(1)) != 0 {
+		return "", fmt.Errorf("value %s has no finite decimal representation", value.String())
+	}
+	return value.FloatString(int(max(twos, fives))), nil
+}
+
+// Returns true if s is a JSON number lexeme as defined by RFC 8259.
+func isNumberLexeme(s string) bool {
+	i := 0
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+	if i < len(s) && s[i] == '0' {
+		i++
+	} else if i < len(s) && s[i] >= '1' && s[i] <= '9' {
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	} else {
+		return false
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	return i == len(s)
+}
@@ -0,0 +1,241 @@
+package json
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFormatFloat(t *testing.T) {
+	successCases := []floatTestCaseStruct{
+		{0, "0"},
+		{1, "1"},
+		{-1.5, "-1.5"},
+		{0.1, "0.1"},
+		{1e20, "100000000000000000000"},
+		{1e21, "1e+21"},
+		{1e-7, "1e-7"},
+		{123456789.125, "123456789.125"},
+	}
+	for _, c := range successCases {
+		got, err := formatFloat(c.input, 64)
+		if err != nil {
+			t.Errorf("error on input: %v: %s", c.input, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("unexpected output on input %v: %s", c.input, got)
+			continue
+		}
+	}
+
+	got, err := formatFloat(float64(float32(0.1)), 32)
+	if err != nil {
+		t.Errorf("error on float32 input: %s", err)
+	} else if got != "0.1" {
+		t.Errorf("unexpected output on float32 input: %s", got)
+	}
+
+	failCases := []float64{math.NaN(), math.Inf(1), math.Inf(-1)}
+	for _, c := range failCases {
+		_, err := formatFloat(c, 64)
+		if err == nil {
+			t.Errorf("expected error on input: %v", c)
+			continue
+		}
+	}
+}
+
+type floatTestCaseStruct struct {
+	input    float64
+	expected string
+}
+
+func TestParseUnsignedInteger(t *testing.T) {
+	successCases := []unsignedIntegerTestCaseStruct{
+		{"0", 8, 0},
+		{"-0", 8, 0},
+		{"255", 8, 255},
+		{"18446744073709551615", 64, 18446744073709551615},
+	}
+	for _, c := range successCases {
+		got, err := parseUnsignedInteger(c.input, c.bitSize)
+		if err != nil {
+			t.Errorf("error on input: %s: %s", c.input, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("unexpected output on input %s: %d", c.input, got)
+			continue
+		}
+	}
+
+	failCases := []unsignedIntegerFailTestCaseStruct{
+		{"-1", 64, ErrNegativeNumber},
+		{"1.5", 64, ErrFractionalNumber},
+		{"1e-1", 64, ErrFractionalNumber},
+		{"256", 8, nil},
+		{"18446744073709551616", 64, nil},
+		{"1e100", 64, nil},
+		{"1.0", 8, nil},
+		{"1e3", 16, nil},
+		{"1e9223372036854775807", 64, nil},
+	}
+	for _, c := range failCases {
+		_, err := parseUnsignedInteger(c.input, c.bitSize)
+		if err == nil {
+			t.Errorf("expected error on input: %s", c.input)
+			continue
+		}
+		if c.expected != nil && !errors.Is(err, c.expected) {
+			t.Errorf("unexpected error on input %s: %s", c.input, err)
+			continue
+		}
+	}
+}
+
+func TestParseSignedInteger(t *testing.T) {
+	successCases := []signedIntegerTestCaseStruct{
+		{"0", 8, 0},
+		{"-0", 8, 0},
+		{"-128", 8, -128},
+		{"9223372036854775807", 64, 9223372036854775807},
+		{"-9223372036854775808", 64, -9223372036854775808},
+	}
+	for _, c := range successCases {
+		got, err := parseSignedInteger(c.input, c.bitSize)
+		if err != nil {
+			t.Errorf("error on input: %s: %s", c.input, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("unexpected output on input %s: %d", c.input, got)
+			continue
+		}
+	}
+
+	failCases := []signedIntegerFailTestCaseStruct{
+		{"1.0", 64, nil},
+		{"1e3", 64, nil},
+		{"1.5", 64, ErrFractionalNumber},
+		{"-1e-1", 64, ErrFractionalNumber},
+		{"128", 8, ErrOutOfRange},
+		{"-9223372036854775809", 64, ErrOutOfRange},
+	}
+	for _, c := range failCases {
+		_, err := parseSignedInteger(c.input, c.bitSize)
+		if err == nil {
+			t.Errorf("expected error on input: %s", c.input)
+			continue
+		}
+		if c.expected != nil && !errors.Is(err, c.expected) {
+			t.Errorf("unexpected error on input %s: %s", c.input, err)
+			continue
+		}
+	}
+}
+
+type signedIntegerTestCaseStruct struct {
+	input    string
+	bitSize  int
+	expected int64
+}
+
+type signedIntegerFailTestCaseStruct struct {
+	input    string
+	bitSize  int
+	expected error
+}
+
+type unsignedIntegerTestCaseStruct struct {
+	input    string
+	bitSize  int
+	expected uint64
+}
+
+type unsignedIntegerFailTestCaseStruct struct {
+	input    string
+	bitSize  int
+	expected error
+}
+
+func TestBigNumberRoundTrip(t *testing.T) {
+	lexemes := []string{
+		"0",
+		"-12345678901234567890123456789",
+		"3.14159265358979323846264338327950288",
+		"1e-30",
+		"0.000125",
+	}
+	for _, lexeme := range lexemes {
+		rat, err := parseRat(lexeme)
+		if err != nil {
+			t.Errorf("error on input: %s: %s", lexeme, err)
+			continue
+		}
+		encoded, err := formatRat(rat)
+		if err != nil {
+			t.Errorf("error on input: %s: %s", lexeme, err)
+			continue
+		}
+		if compareNumbers(encoded, lexeme) != 0 {
+			t.Errorf("unexpected output on input %s: %s", lexeme, encoded)
+			continue
+		}
+	}
+
+	integer, err := parseBigInt("1500000000000000000000000000000")
+	if err != nil {
+		t.Fatalf("failed to parse big.Int: %s", err)
+	}
+	if integer.String() != "1500000000000000000000000000000" {
+		t.Errorf("unexpected big.Int: %s", integer.String())
+	}
+	_, err = parseBigInt("1.5")
+	if !errors.Is(err, ErrFractionalNumber) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	_, err = parseBigInt("1.5e30")
+	if err == nil {
+		t.Error("expected error on exponent")
+	}
+
+	_, err = formatRat(big.NewRat(1, 3))
+	if err == nil {
+		t.Errorf("expected error on input: 1/3")
+	}
+}
+
+func TestCompareNumbers(t *testing.T) {
+	testCases := []compareNumbersTestCaseStruct{
+		{"1", "1.0", 0},
+		{"1e2", "100", 0},
+		{"-0", "0", 0},
+		{"9", "10", -1},
+		{"-9", "-10", 1},
+		{"0.5", "5e-1", 0},
+		{"1e9223372036854775807", "1", 1},
+		{"1e9223372036854775807", "9e9223372036854775806", 1},
+		{"10e9223372036854775807", "1e9223372036854775807", 1},
+		{"-1e9223372036854775807", "-1", -1},
+		{"1e-9223372036854775808", "0", 1},
+		{"1e-9223372036854775808", "1e-9223372036854775807", -1},
+		{"1.5e-9223372036854775807", "1", -1},
+		{"-1e-9223372036854775808", "0", -1},
+	}
+	for _, c := range testCases {
+		if got := compareNumbers(c.a, c.b); got != c.expected {
+			t.Errorf("unexpected result on input %s and %s: %d", c.a, c.b, got)
+		}
+		if got := compareNumbers(c.b, c.a); got != -c.expected {
+			t.Errorf("unexpected result on input %s and %s: %d", c.b, c.a, got)
+		}
+	}
+}
+
+type compareNumbersTestCaseStruct struct {
+	a        string
+	b        string
+	expected int
+}
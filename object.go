@@ -2,6 +2,7 @@ package json
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 )
 
@@ -11,9 +12,13 @@ type ObjectStruct struct {
 	numbers map[string]string
 	bools   map[string]bool
 	nulls   map[string]struct{}
-	objects map[string]ObjectStruct
-	arrays  map[string]ArrayStruct
+	objects map[string]*ObjectStruct
+	arrays  map[string]*ArrayStruct
 	Keys    []string // Read-only.
+
+	frozen bool
+	// If true, the maps are shared with a frozen object and are copied before the first write.
+	copyOnWrite bool
 }
 
 func NewObject() ObjectStruct {
@@ -22,8 +27,8 @@ func NewObject() ObjectStruct {
 		numbers: map[string]string{},
 		bools:   map[string]bool{},
 		nulls:   map[string]struct{}{},
-		objects: map[string]ObjectStruct{},
-		arrays:  map[string]ArrayStruct{},
+		objects: map[string]*ObjectStruct{},
+		arrays:  map[string]*ArrayStruct{},
 		Keys:    nil,
 	}
 	return object
@@ -52,6 +57,7 @@ func (object *ObjectStruct) Has(key string) bool {
 }
 
 func (object *ObjectStruct) addKey(key string) {
+	object.prepareWrite()
 	if _, ok := object.strings[key]; ok {
 		delete(object.strings, key)
 	} else if _, ok := object.numbers[key]; ok {
@@ -69,6 +75,57 @@ func (object *ObjectStruct) addKey(key string) {
 	}
 }
 
+// Returns an error if the key doesn't exist.
+func (object *ObjectStruct) Get(key string) (ValueStruct, error) {
+	value, ok := object.get(key)
+	if !ok {
+		return ValueStruct{}, fmt.Errorf("member %s %w", key, ErrNotFound)
+	}
+	return value, nil
+}
+
+// Set a member with a JSON value.
+// Overrides any member with the same name.
+func (object *ObjectStruct) Set(key string, value ValueStruct) {
+	switch value.kind {
+	case KindString:
+		object.SetString(key, value.s)
+	case KindNumber:
+		object.SetNumber(key, value.s)
+	case KindBool:
+		object.SetBool(key, value.b)
+	case KindObject:
+		object.SetJSONObject(key, value.object)
+	case KindArray:
+		object.SetJSONArray(key, value.array)
+	default:
+		object.SetNull(key)
+	}
+}
+
+// Removes a member.
+// Returns false if the key doesn't exist.
+func (object *ObjectStruct) Delete(key string) bool {
+	object.prepareWrite()
+	if !object.Has(key) {
+		return false
+	}
+	delete(object.strings, key)
+	delete(object.numbers, key)
+	delete(object.bools, key)
+	delete(object.nulls, key)
+	delete(object.objects, key)
+	delete(object.arrays, key)
+	keys := make([]string, 0, len(object.Keys)-1)
+	for _, k := range object.Keys {
+		if k != key {
+			keys = append(keys, k)
+		}
+	}
+	object.Keys = keys
+	return true
+}
+
 // Set a member with a JSON string value.
 // Overrides any member with the same name.
 func (object *ObjectStruct) SetString(key string, value string) {
@@ -80,7 +137,7 @@ func (object *ObjectStruct) SetString(key string, value string) {
 func (object *ObjectStruct) GetString(key string) (string, error) {
 	value, ok := object.strings[key]
 	if !ok {
-		return "", fmt.Errorf("no matching member")
+		return "", object.memberError(key, KindString)
 	}
 	return value, nil
 }
@@ -96,7 +153,7 @@ func (object *ObjectStruct) SetNumber(key string, value string) {
 func (object *ObjectStruct) GetNumber(key string) (string, error) {
 	value, ok := object.numbers[key]
 	if !ok {
-		return "", fmt.Errorf("no matching member")
+		return "", object.memberError(key, KindNumber)
 	}
 	return value, nil
 }
@@ -113,13 +170,13 @@ func (object *ObjectStruct) SetInt(key string, value int) {
 func (object *ObjectStruct) GetInt(key string) (int, error) {
 	value, err := object.GetNumber(key)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get number: %s", err.Error())
+		return 0, fmt.Errorf("failed to get number: %w", err)
 	}
-	parsed, err := strconv.Atoi(value)
+	parsed, err := parseSignedInteger(value, strconv.IntSize)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse int: %s", err.Error())
+		return 0, fmt.Errorf("failed to parse int: %w", err)
 	}
-	return parsed, nil
+	return int(parsed), nil
 }
 
 // Set a member with a JSON number value.
@@ -134,11 +191,11 @@ func (object *ObjectStruct) SetInt32(key string, value int32) {
 func (object *ObjectStruct) GetInt32(key string) (int32, error) {
 	value, err := object.GetNumber(key)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get number: %s", err.Error())
+		return 0, fmt.Errorf("failed to get number: %w", err)
 	}
-	parsed, err := strconv.ParseInt(value, 10, 32)
+	parsed, err := parseSignedInteger(value, 32)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse int32: %s", err.Error())
+		return 0, fmt.Errorf("failed to parse int32: %w", err)
 	}
 	return int32(parsed), nil
 }
@@ -155,11 +212,246 @@ func (object *ObjectStruct) SetInt64(key string, value int64) {
 func (object *ObjectStruct) GetInt64(key string) (int64, error) {
 	value, err := object.GetNumber(key)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get number: %s", err.Error())
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseSignedInteger(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int64: %w", err)
+	}
+	return parsed, nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetUint(key string, value uint) {
+	object.SetNumber(key, strconv.FormatUint(uint64(value), 10))
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (object *ObjectStruct) GetUint(key string) (uint, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, strconv.IntSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint: %w", err)
+	}
+	return uint(parsed), nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetUint8(key string, value uint8) {
+	object.SetNumber(key, strconv.FormatUint(uint64(value), 10))
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint8.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (object *ObjectStruct) GetUint8(key string) (uint8, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, 8)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint8: %w", err)
+	}
+	return uint8(parsed), nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetUint16(key string, value uint16) {
+	object.SetNumber(key, strconv.FormatUint(uint64(value), 10))
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint16.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (object *ObjectStruct) GetUint16(key string) (uint16, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, 16)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint16: %w", err)
+	}
+	return uint16(parsed), nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetUint32(key string, value uint32) {
+	object.SetNumber(key, strconv.FormatUint(uint64(value), 10))
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint32.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (object *ObjectStruct) GetUint32(key string) (uint32, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, 32)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint32: %w", err)
+	}
+	return uint32(parsed), nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetUint64(key string, value uint64) {
+	object.SetNumber(key, strconv.FormatUint(value, 10))
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON number,
+// or the JSON number cannot be represented as a uint64.
+// Negative and fractional numbers return errors wrapping [ErrNegativeNumber] and [ErrFractionalNumber].
+func (object *ObjectStruct) GetUint64(key string) (uint64, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnsignedInteger(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint64: %w", err)
+	}
+	return parsed, nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+// Returns an error if the value is NaN or infinite.
+func (object *ObjectStruct) SetFloat64(key string, value float64) error {
+	encoded, err := formatFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("failed to format float64: %w", err)
+	}
+	object.SetNumber(key, encoded)
+	return nil
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON number,
+// or the JSON number is outside the range of a float64.
+func (object *ObjectStruct) GetFloat64(key string) (float64, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
 	}
-	parsed, err := strconv.ParseInt(value, 10, 64)
+	parsed, err := parseFloat(value, 64)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse int64: %s", err.Error())
+		return 0, fmt.Errorf("failed to parse float64: %w", err)
+	}
+	return parsed, nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+// Returns an error if the value is NaN or infinite.
+func (object *ObjectStruct) SetFloat32(key string, value float32) error {
+	encoded, err := formatFloat(float64(value), 32)
+	if err != nil {
+		return fmt.Errorf("failed to format float32: %w", err)
+	}
+	object.SetNumber(key, encoded)
+	return nil
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON number,
+// or the JSON number is outside the range of a float32.
+func (object *ObjectStruct) GetFloat32(key string) (float32, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseFloat(value, 32)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float32: %w", err)
+	}
+	return float32(parsed), nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetBigInt(key string, value *big.Int) {
+	object.SetNumber(key, value.String())
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON number,
+// or the JSON number isn't an integer.
+func (object *ObjectStruct) GetBigInt(key string) (*big.Int, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseBigInt(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse big.Int: %w", err)
+	}
+	return parsed, nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+// Returns an error if the value is infinite.
+func (object *ObjectStruct) SetBigFloat(key string, value *big.Float) error {
+	encoded, err := formatBigFloat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Float: %w", err)
+	}
+	object.SetNumber(key, encoded)
+	return nil
+}
+
+// Returns an error if the key doesn't exist or the value isn't a JSON number.
+func (object *ObjectStruct) GetBigFloat(key string) (*big.Float, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseBigFloat(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse big.Float: %w", err)
+	}
+	return parsed, nil
+}
+
+// Set a member with a JSON number value.
+// Overrides any member with the same name.
+// Returns an error if the value has no finite decimal representation.
+func (object *ObjectStruct) SetRat(key string, value *big.Rat) error {
+	encoded, err := formatRat(value)
+	if err != nil {
+		return fmt.Errorf("failed to format big.Rat: %w", err)
+	}
+	object.SetNumber(key, encoded)
+	return nil
+}
+
+// Returns an error if the key doesn't exist or the value isn't a JSON number.
+func (object *ObjectStruct) GetRat(key string) (*big.Rat, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseRat(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse big.Rat: %w", err)
 	}
 	return parsed, nil
 }
@@ -175,7 +467,7 @@ func (object *ObjectStruct) SetBool(key string, value bool) {
 func (object *ObjectStruct) GetBool(key string) (bool, error) {
 	value, ok := object.bools[key]
 	if !ok {
-		return false, fmt.Errorf("no matching member")
+		return false, object.memberError(key, KindBool)
 	}
 	return value, nil
 }
@@ -184,14 +476,38 @@ func (object *ObjectStruct) GetBool(key string) (bool, error) {
 // Overrides any member with the same name.
 func (object *ObjectStruct) SetJSONObject(key string, value ObjectStruct) {
 	object.addKey(key)
-	object.objects[key] = value
+	object.objects[key] = &value
 }
 
 // Returns an error if the key doesn't exist or the value isn't a JSON object.
+// Use ObjectStruct.Object() to modify the member in place.
 func (object *ObjectStruct) GetJSONObject(key string) (ObjectStruct, error) {
 	value, ok := object.objects[key]
 	if !ok {
-		return ObjectStruct{}, fmt.Errorf("no matching member")
+		return ObjectStruct{}, object.memberError(key, KindObject)
+	}
+	return object.readObject(value), nil
+}
+
+// Returns a pointer to a JSON object member.
+// Changes made through the pointer are reflected in the object.
+// The pointer is no longer valid once the member is replaced or removed.
+// If the object is frozen, the returned object is a frozen copy.
+// Returns an error if the key doesn't exist or the value isn't a JSON object.
+func (object *ObjectStruct) Object(key string) (*ObjectStruct, error) {
+	value, ok := object.objects[key]
+	if !ok {
+		return nil, object.memberError(key, KindObject)
+	}
+	if object.frozen {
+		copied := *value
+		return &copied, nil
+	}
+	if value.frozen {
+		thawed := value.Thaw()
+		object.prepareWrite()
+		object.objects[key] = &thawed
+		return &thawed, nil
 	}
 	return value, nil
 }
@@ -200,14 +516,38 @@ func (object *ObjectStruct) GetJSONObject(key string) (ObjectStruct, error) {
 // Overrides any member with the same name.
 func (object *ObjectStruct) SetJSONArray(key string, value ArrayStruct) {
 	object.addKey(key)
-	object.arrays[key] = value
+	object.arrays[key] = &value
 }
 
 // Returns an error if the key doesn't exist or the value isn't a JSON array.
+// Use ObjectStruct.Array() to modify the member in place.
 func (object *ObjectStruct) GetJSONArray(key string) (ArrayStruct, error) {
 	value, ok := object.arrays[key]
 	if !ok {
-		return ArrayStruct{}, fmt.Errorf("no matching member")
+		return ArrayStruct{}, object.memberError(key, KindArray)
+	}
+	return object.readArray(value), nil
+}
+
+// Returns a pointer to a JSON array member.
+// Changes made through the pointer are reflected in the object.
+// The pointer is no longer valid once the member is replaced or removed.
+// If the object is frozen, the returned array is a frozen copy.
+// Returns an error if the key doesn't exist or the value isn't a JSON array.
+func (object *ObjectStruct) Array(key string) (*ArrayStruct, error) {
+	value, ok := object.arrays[key]
+	if !ok {
+		return nil, object.memberError(key, KindArray)
+	}
+	if object.frozen {
+		copied := *value
+		return &copied, nil
+	}
+	if value.frozen {
+		thawed := value.Thaw()
+		object.prepareWrite()
+		object.arrays[key] = &thawed
+		return &thawed, nil
 	}
 	return value, nil
 }
@@ -219,11 +559,11 @@ func (object *ObjectStruct) SetNull(key string) {
 	object.nulls[key] = struct{}{}
 }
 
-// Returns an error if the key doesn't exist.
+// Returns an error if the key doesn't exist or the value isn't null.
 func (object *ObjectStruct) IsNull(key string) (bool, error) {
 	_, ok := object.nulls[key]
 	if !ok {
-		return false, fmt.Errorf("no matching member")
+		return false, object.memberError(key, KindNull)
 	}
 	return true, nil
 }
@@ -237,6 +577,16 @@ func (object *ObjectStruct) ExistsAndIsNull(key string) bool {
 	return ok
 }
 
+// Returns a deep copy of the object.
+func (object *ObjectStruct) Clone() ObjectStruct {
+	cloned := NewObject()
+	for _, key := range object.Keys {
+		value, _ := object.get(key)
+		cloned.Set(key, value.Clone())
+	}
+	return cloned
+}
+
 // Encodes the object using ObjectBuilderStruct.
 // Embedded objects are encoded with ObjectStruct.String().
 // Embedded arrays are encoded with ArrayStruct.String().
@@ -0,0 +1,62 @@
+package json
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestObjectPointerAccessors(t *testing.T) {
+	object, err := ParseObject(`{"a":{"b":1},"c":[1,{"d":2}],"e":"f"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested, err := object.Object("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested.SetString("g", "h")
+	array, err := object.Array("c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	array.AddNull()
+	element, err := array.Object(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	array.Insert(0, NewBoolValue(true))
+	element.SetNumber("i", "3")
+	expected := `{"a":{"b":1,"g":"h"},"c":[true,1,{"d":2,"i":3},null],"e":"f"}`
+	if got := object.String(MinimalStringCharacterEscapingBehavior); got != expected {
+		t.Errorf("unexpected output: %s", got)
+	}
+
+	_, err = object.Object("e")
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch, got %v", err)
+	}
+	_, err = object.Array("x")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	object.Freeze()
+	frozen, err := object.Object("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectFrozenPanic(t, func() { frozen.SetNull("b") })
+
+	thawed := object.Thaw()
+	nested, err = thawed.Object("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested.SetNull("b")
+	if got := thawed.String(MinimalStringCharacterEscapingBehavior); got != `{"a":{"b":null,"g":"h"},"c":[true,1,{"d":2,"i":3},null],"e":"f"}` {
+		t.Errorf("unexpected thawed output: %s", got)
+	}
+	if got := object.String(MinimalStringCharacterEscapingBehavior); got != expected {
+		t.Errorf("frozen object modified: %s", got)
+	}
+}
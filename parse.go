@@ -51,6 +51,89 @@ func ParseArray(s string) (ArrayStruct, error) {
 	return parsed, nil
 }
 
+// Parses any JSON value. Ignores any leading and trailing whitespace.
+// Returns an error if the string is an invalid JSON value or
+// an object has duplicate member names.
+//
+// JSON object member names are compared after resolving any escaped characters.
+func ParseValue(s string) (ValueStruct, error) {
+	r := strings.NewReader(s)
+
+	parsed, err := parseEmbeddedValue(r)
+	if err != nil {
+		return ValueStruct{}, fmt.Errorf("failed to parse embedded value: %s", err.Error())
+	}
+
+	err = parseEnd(r)
+	if err != nil {
+		return ValueStruct{}, fmt.Errorf("failed to parse end: %s", err.Error())
+	}
+
+	return parsed, nil
+}
+
+func parseEmbeddedValue(r io.RuneScanner) (ValueStruct, error) {
+	err := skipWhitespace(r)
+	if err != nil {
+		return ValueStruct{}, fmt.Errorf("failed to skip whitespace: %s", err.Error())
+	}
+
+	nextChar, _, err := r.ReadRune()
+	if err != nil {
+		return ValueStruct{}, fmt.Errorf("failed to read rune: %s", err.Error())
+	}
+	if nextChar == unicode.ReplacementChar {
+		return ValueStruct{}, fmt.Errorf("invalid encoding")
+	}
+	err = r.UnreadRune()
+	if err != nil {
+		return ValueStruct{}, fmt.Errorf("failed to unread rune: %s", err.Error())
+	}
+
+	if nextChar == '{' {
+		value, err := parseEmbeddedObject(r)
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to parse embedded object: %s", err.Error())
+		}
+		return NewJSONObjectValue(value), nil
+	}
+	if nextChar == '[' {
+		value, err := parseEmbeddedArray(r)
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to parse embedded array: %s", err.Error())
+		}
+		return NewJSONArrayValue(value), nil
+	}
+	if nextChar == '"' {
+		value, err := parseString(r)
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to parse string: %s", err.Error())
+		}
+		return NewStringValue(value), nil
+	}
+	if nextChar == '-' || isDigitCharacter(nextChar) {
+		value, err := extractNumber(r)
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to extract number: %s", err.Error())
+		}
+		return NewNumberValue(value), nil
+	}
+
+	value, err := extractIdentifier(r)
+	if err != nil {
+		return ValueStruct{}, fmt.Errorf("failed to extract identifier: %s", err.Error())
+	}
+	switch value {
+	case "true":
+		return NewBoolValue(true), nil
+	case "false":
+		return NewBoolValue(false), nil
+	case "null":
+		return NewNullValue(), nil
+	}
+	return ValueStruct{}, fmt.Errorf("unexpected identifier %s", value)
+}
+
 func parseEnd(r io.RuneScanner) error {
 	for {
 		char, _, err := r.ReadRune()
@@ -169,7 +252,7 @@ func parseEmbeddedObject(r io.RuneScanner) (ObjectStruct, error) {
 				return ObjectStruct{}, fmt.Errorf("failed to parse string: %s", err.Error())
 			}
 			object.SetString(key, value)
-		} else if isDigitCharacter(nextChar) {
+		} else if nextChar == '-' || isDigitCharacter(nextChar) {
 			value, err := extractNumber(r)
 			if err != nil {
 				return ObjectStruct{}, fmt.Errorf("failed to extract number: %s", err.Error())
@@ -284,7 +367,7 @@ func parseEmbeddedArray(r io.RuneScanner) (ArrayStruct, error) {
 				return ArrayStruct{}, fmt.Errorf("failed to parse string: %s", err.Error())
 			}
 			array.AddString(value)
-		} else if isDigitCharacter(nextChar) {
+		} else if nextChar == '-' || isDigitCharacter(nextChar) {
 			value, err := extractNumber(r)
 			if err != nil {
 				return ArrayStruct{}, fmt.Errorf("failed to extract number: %s", err.Error())
@@ -466,6 +549,9 @@ func extractNumber(r io.RuneScanner) (string, error) {
 		extracted = append(extracted, char)
 		for {
 			char, _, err = r.ReadRune()
+			if err != nil && errors.Is(err, io.EOF) {
+				break
+			}
 			if err != nil {
 				return "", fmt.Errorf("failed to read rune: %s", err.Error())
 			}
@@ -497,8 +583,24 @@ func extractNumber(r io.RuneScanner) (string, error) {
 	}
 	if char == '.' {
 		extracted = append(extracted, char)
+
+		char, _, err = r.ReadRune()
+		if err != nil {
+			return "", fmt.Errorf("failed to read rune: %s", err.Error())
+		}
+		if char == unicode.ReplacementChar {
+			return "", fmt.Errorf("invalid encoding")
+		}
+		if !isDigitCharacter(char) {
+			return "", fmt.Errorf("unexpected character %s", string(char))
+		}
+		extracted = append(extracted, char)
+
 		for {
 			char, _, err = r.ReadRune()
+			if err != nil && errors.Is(err, io.EOF) {
+				break
+			}
 			if err != nil {
 				return "", fmt.Errorf("failed to read rune: %s", err.Error())
 			}
@@ -45,3 +45,22 @@ type successTestCaseStruct struct {
 	input    string
 	expected string
 }
+
+func TestParseObject(t *testing.T) {
+	object, err := ParseObject(`{"a": -1, "b": [-0.5e-3, 2]}`)
+	if err != nil {
+		t.Fatalf("failed to parse object: %s", err)
+	}
+	a, err := object.GetNumber("a")
+	if err != nil || a != "-1" {
+		t.Errorf("unexpected member a: %s", a)
+	}
+	b, err := object.GetJSONArray("b")
+	if err != nil {
+		t.Fatalf("failed to get member b: %s", err)
+	}
+	element, err := b.GetNumber(0)
+	if err != nil || element != "-0.5e-3" {
+		t.Errorf("unexpected element: %s", element)
+	}
+}
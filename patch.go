@@ -0,0 +1,161 @@
+package json
+
+import (
+	"fmt"
+)
+
+// Applies a JSON Patch (RFC 6902) and returns the patched document.
+// Supports the add, remove, replace, move, copy, and test operations.
+//
+// Operations are applied to a deep copy of the document, so the document is left unchanged
+// regardless of whether an error is returned.
+// Returns an error if an operation is invalid, references a location that doesn't exist,
+// or a test operation fails. No partial result is returned.
+func ApplyPatch(doc ValueStruct, patch ArrayStruct) (ValueStruct, error) {
+	result := doc.Clone()
+	for i := range patch.Length {
+		operation, err := patch.GetJSONObject(i)
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to get operation %d: %w", i, err)
+		}
+		result, err = applyPatchOperation(result, operation)
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to apply operation %d: %w", i, err)
+		}
+	}
+	return result, nil
+}
+
+func applyPatchOperation(doc ValueStruct, operation ObjectStruct) (ValueStruct, error) {
+	op, err := operation.GetString("op")
+	if err != nil {
+		return ValueStruct{}, fmt.Errorf("failed to get op: %w", err)
+	}
+	path, err := getPatchOperationPointer(operation, "path")
+	if err != nil {
+		return ValueStruct{}, err
+	}
+
+	switch op {
+	case "add":
+		value, err := operation.Get("value")
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to get value: %w", err)
+		}
+		return addPatchValue(doc, path, value.Clone())
+	case "remove":
+		if len(path) == 0 {
+			return ValueStruct{}, fmt.Errorf("cannot remove root")
+		}
+		return deleteJSONPointerValue(doc, path)
+	case "replace":
+		value, err := operation.Get("value")
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to get value: %w", err)
+		}
+		_, err = resolveJSONPointer(doc, path)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		if len(path) == 0 {
+			return value.Clone(), nil
+		}
+		return setJSONPointerValue(doc, path, value.Clone(), false)
+	case "move":
+		from, err := getPatchOperationPointer(operation, "from")
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		if isProperJSONPointerPrefix(from, path) {
+			return ValueStruct{}, fmt.Errorf("cannot move a value into one of its children")
+		}
+		value, err := resolveJSONPointer(doc, from)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		if len(from) == 0 {
+			return value, nil
+		}
+		doc, err = deleteJSONPointerValue(doc, from)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		return addPatchValue(doc, path, value)
+	case "copy":
+		from, err := getPatchOperationPointer(operation, "from")
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		value, err := resolveJSONPointer(doc, from)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		return addPatchValue(doc, path, value.Clone())
+	case "test":
+		expected, err := operation.Get("value")
+		if err != nil {
+			return ValueStruct{}, fmt.Errorf("failed to get value: %w", err)
+		}
+		actual, err := resolveJSONPointer(doc, path)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		if !Equal(actual, expected, EqualityOptionsStruct{CompareNumbersByValue: true}) {
+			return ValueStruct{}, fmt.Errorf("test failed at %s", formatJSONPointer(path))
+		}
+		return doc, nil
+	}
+	return ValueStruct{}, fmt.Errorf("unknown op %s", op)
+}
+
+func getPatchOperationPointer(operation ObjectStruct, name string) ([]string, error) {
+	pointer, err := operation.GetString(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", name, err)
+	}
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return tokens, nil
+}
+
+// Adds the value to the location referenced by tokens.
+// Object members are replaced and array elements are inserted.
+func addPatchValue(doc ValueStruct, tokens []string, value ValueStruct) (ValueStruct, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	return updateJSONPointerParent(doc, tokens, 0, false, func(parent ValueStruct, tokens []string) (ValueStruct, error) {
+		token := tokens[len(tokens)-1]
+		switch parent.kind {
+		case KindObject:
+			parent.object.Set(token, value)
+			return parent, nil
+		case KindArray:
+			if token == "-" {
+				parent.array.Add(value)
+				return parent, nil
+			}
+			index, err := parseJSONPointerArrayIndex(token)
+			if err != nil || index > parent.array.Length {
+				return ValueStruct{}, jsonPointerNotFoundError(tokens)
+			}
+			parent.array.Insert(index, value)
+			return parent, nil
+		}
+		return ValueStruct{}, jsonPointerNotFoundError(tokens)
+	})
+}
+
+func isProperJSONPointerPrefix(prefix []string, tokens []string) bool {
+	if len(prefix) >= len(tokens) {
+		return false
+	}
+	for i, token := range prefix {
+		if tokens[i] != token {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package json
+
+import (
+	"testing"
+)
+
+func TestApplyPatch(t *testing.T) {
+	successCases := []patchTestCaseStruct{
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"foo":"bar","baz":"qux"}`},
+		{`{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`},
+		{`{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`, `{"baz":"boo","foo":"bar"}`},
+		{`{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`, `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`, `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`},
+		{`{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`, `{"foo":["all","cows","eat","grass"]}`},
+		{`{"baz":"qux","foo":["a",2,"c"]}`, `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2.0}]`, `{"baz":"qux","foo":["a",2,"c"]}`},
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`, `{"foo":"bar","child":{"grandchild":{}}}`},
+		{`{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`, `{"foo":["bar",["abc","def"]]}`},
+		{`{"foo":{"bar":1}}`, `[{"op":"copy","from":"/foo","path":"/baz"},{"op":"add","path":"/baz/qux","value":2}]`, `{"foo":{"bar":1},"baz":{"bar":1,"qux":2}}`},
+		{`{"foo":"bar"}`, `[{"op":"replace","path":"","value":{"baz":1}}]`, `{"baz":1}`},
+	}
+	for _, c := range successCases {
+		doc, err := ParseObject(c.doc)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.doc, err)
+		}
+		patch, err := ParseArray(c.patch)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.patch, err)
+		}
+		result, err := ApplyPatch(NewJSONObjectValue(doc), patch)
+		if err != nil {
+			t.Errorf("error on input: %s: %s", c.patch, err)
+			continue
+		}
+		got := result.String(MinimalStringCharacterEscapingBehavior)
+		if got != c.expected {
+			t.Errorf("unexpected output on input %s: %s", c.patch, got)
+			continue
+		}
+		if doc.String(MinimalStringCharacterEscapingBehavior) != c.doc {
+			t.Errorf("document modified on input %s", c.patch)
+			continue
+		}
+	}
+
+	failCases := []patchTestCaseStruct{
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`, ""},
+		{`{"foo":["bar"]}`, `[{"op":"add","path":"/foo/2","value":"qux"}]`, ""},
+		{`{"foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, ""},
+		{`{"foo":"bar"}`, `[{"op":"replace","path":"/baz","value":1}]`, ""},
+		{`{"foo":{"bar":1}}`, `[{"op":"move","from":"/foo","path":"/foo/bar/baz"}]`, ""},
+		{`{"baz":"qux"}`, `[{"op":"add","path":"/foo","value":1},{"op":"test","path":"/baz","value":"bar"}]`, ""},
+		{`{"foo":"bar"}`, `[{"op":"delete","path":"/foo"}]`, ""},
+		{`{"foo":"bar"}`, `[{"op":"add","path":"foo","value":1}]`, ""},
+	}
+	for _, c := range failCases {
+		doc, err := ParseObject(c.doc)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.doc, err)
+		}
+		patch, err := ParseArray(c.patch)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", c.patch, err)
+		}
+		_, err = ApplyPatch(NewJSONObjectValue(doc), patch)
+		if err == nil {
+			t.Errorf("expected error on input: %s", c.patch)
+			continue
+		}
+		if doc.String(MinimalStringCharacterEscapingBehavior) != c.doc {
+			t.Errorf("document modified on input %s", c.patch)
+			continue
+		}
+	}
+}
+
+type patchTestCaseStruct struct {
+	doc      string
+	patch    string
+	expected string
+}
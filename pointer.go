@@ -0,0 +1,499 @@
+package json
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parses a JSON Pointer (RFC 6901) into its reference tokens.
+// An empty string refers to the whole document and returns no tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("pointer must start with /")
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		unescaped, err := unescapeJSONPointerToken(token)
+		if err != nil {
+			return nil, err
+		}
+		tokens[i] = unescaped
+	}
+	return tokens, nil
+}
+
+func unescapeJSONPointerToken(token string) (string, error) {
+	if !strings.Contains(token, "~") {
+		return token, nil
+	}
+	b := strings.Builder{}
+	for i := 0; i < len(token); i++ {
+		if token[i] != '~' {
+			b.WriteByte(token[i])
+			continue
+		}
+		if i+1 >= len(token) {
+			return "", fmt.Errorf("invalid escape sequence in token %s", token)
+		}
+		switch token[i+1] {
+		case '0':
+			b.WriteByte('~')
+		case '1':
+			b.WriteByte('/')
+		default:
+			return "", fmt.Errorf("invalid escape sequence in token %s", token)
+		}
+		i++
+	}
+	return b.String(), nil
+}
+
+var jsonPointerTokenEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func escapeJSONPointerToken(token string) string {
+	return jsonPointerTokenEscaper.Replace(token)
+}
+
+func formatJSONPointer(tokens []string) string {
+	b := strings.Builder{}
+	for _, token := range tokens {
+		b.WriteRune('/')
+		b.WriteString(escapeJSONPointerToken(token))
+	}
+	return b.String()
+}
+
+// Parses an array index reference token.
+// Leading zeros are not allowed.
+func parseJSONPointerArrayIndex(token string) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %s", token)
+	}
+	for _, char := range token {
+		if !isDigitCharacter(char) {
+			return 0, fmt.Errorf("invalid array index %s", token)
+		}
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid array index %s", token)
+	}
+	return index, nil
+}
+
+func resolveJSONPointer(target ValueStruct, tokens []string) (ValueStruct, error) {
+	for i, token := range tokens {
+		switch target.kind {
+		case KindObject:
+			child, ok := target.object.get(token)
+			if !ok {
+				return ValueStruct{}, jsonPointerNotFoundError(tokens[:i+1])
+			}
+			target = child
+		case KindArray:
+			index, err := parseJSONPointerArrayIndex(token)
+			if err != nil {
+				return ValueStruct{}, jsonPointerNotFoundError(tokens[:i+1])
+			}
+			child, ok := target.array.get(index)
+			if !ok {
+				return ValueStruct{}, jsonPointerNotFoundError(tokens[:i+1])
+			}
+			target = child
+		default:
+			return ValueStruct{}, jsonPointerNotFoundError(tokens[:i+1])
+		}
+	}
+	return target, nil
+}
+
+func jsonPointerNotFoundError(tokens []string) error {
+	return fmt.Errorf("%s %w", formatJSONPointer(tokens), ErrNotFound)
+}
+
+// Returns the target after replacing the parent of the location referenced by tokens
+// with the result of update, which receives the parent and all tokens.
+// Nested objects and arrays are modified in place and then set again on their parent,
+// so that changes to their keys and lengths are propagated.
+// Nothing is modified if update returns an error without modifying the parent.
+func updateJSONPointerParent(target ValueStruct, tokens []string, depth int, createIntermediates bool, update func(parent ValueStruct, tokens []string) (ValueStruct, error)) (ValueStruct, error) {
+	if depth == len(tokens)-1 {
+		return update(target, tokens)
+	}
+	token := tokens[depth]
+	switch target.kind {
+	case KindObject:
+		child, ok := target.object.get(token)
+		if !ok {
+			if !createIntermediates {
+				return ValueStruct{}, jsonPointerNotFoundError(tokens[:depth+1])
+			}
+			child = NewJSONObjectValue(NewObject())
+		}
+		updated, err := updateJSONPointerParent(child, tokens, depth+1, createIntermediates, update)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		target.object.Set(token, updated)
+		return target, nil
+	case KindArray:
+		if token == "-" {
+			if !createIntermediates {
+				return ValueStruct{}, jsonPointerNotFoundError(tokens[:depth+1])
+			}
+			updated, err := updateJSONPointerParent(NewJSONObjectValue(NewObject()), tokens, depth+1, createIntermediates, update)
+			if err != nil {
+				return ValueStruct{}, err
+			}
+			target.array.Add(updated)
+			return target, nil
+		}
+		index, err := parseJSONPointerArrayIndex(token)
+		if err != nil || index >= target.array.Length {
+			return ValueStruct{}, jsonPointerNotFoundError(tokens[:depth+1])
+		}
+		child, _ := target.array.get(index)
+		updated, err := updateJSONPointerParent(child, tokens, depth+1, createIntermediates, update)
+		if err != nil {
+			return ValueStruct{}, err
+		}
+		target.array.Set(index, updated)
+		return target, nil
+	}
+	return ValueStruct{}, jsonPointerNotFoundError(tokens[:depth+1])
+}
+
+// Returns the index referenced by the last token if parent is an array.
+// The "-" token is only accepted if allowEnd is true and references the array length.
+func jsonPointerParentArrayIndex(parent ValueStruct, tokens []string, allowEnd bool) (int, error) {
+	token := tokens[len(tokens)-1]
+	if token == "-" && allowEnd {
+		return parent.array.Length, nil
+	}
+	index, err := parseJSONPointerArrayIndex(token)
+	if err != nil || index >= parent.array.Length {
+		return 0, jsonPointerNotFoundError(tokens)
+	}
+	return index, nil
+}
+
+// Returns the target with the value set at the location referenced by tokens.
+// Existing values are replaced and the "-" token appends to an array.
+func setJSONPointerValue(target ValueStruct, tokens []string, value ValueStruct, createIntermediates bool) (ValueStruct, error) {
+	return updateJSONPointerParent(target, tokens, 0, createIntermediates, func(parent ValueStruct, tokens []string) (ValueStruct, error) {
+		token := tokens[len(tokens)-1]
+		switch parent.kind {
+		case KindObject:
+			parent.object.Set(token, value)
+			return parent, nil
+		case KindArray:
+			index, err := jsonPointerParentArrayIndex(parent, tokens, true)
+			if err != nil {
+				return ValueStruct{}, err
+			}
+			if index == parent.array.Length {
+				parent.array.Add(value)
+			} else {
+				parent.array.Set(index, value)
+			}
+			return parent, nil
+		}
+		return ValueStruct{}, jsonPointerNotFoundError(tokens)
+	})
+}
+
+// Returns the target with the value at the location referenced by tokens removed.
+func deleteJSONPointerValue(target ValueStruct, tokens []string) (ValueStruct, error) {
+	return updateJSONPointerParent(target, tokens, 0, false, func(parent ValueStruct, tokens []string) (ValueStruct, error) {
+		token := tokens[len(tokens)-1]
+		switch parent.kind {
+		case KindObject:
+			if !parent.object.Delete(token) {
+				return ValueStruct{}, jsonPointerNotFoundError(tokens)
+			}
+			return parent, nil
+		case KindArray:
+			index, err := jsonPointerParentArrayIndex(parent, tokens, false)
+			if err != nil {
+				return ValueStruct{}, err
+			}
+			parent.array.Remove(index)
+			return parent, nil
+		}
+		return ValueStruct{}, jsonPointerNotFoundError(tokens)
+	})
+}
+
+// Returns the value referenced by the JSON Pointer (RFC 6901).
+// Returns an error if the pointer is invalid or the value doesn't exist.
+func (object *ObjectStruct) GetAt(pointer string) (ValueStruct, error) {
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return ValueStruct{}, fmt.Errorf("failed to parse pointer: %w", err)
+	}
+	return resolveJSONPointer(NewJSONObjectValue(*object), tokens)
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON string.
+func (object *ObjectStruct) GetStringAt(pointer string) (string, error) {
+	value, err := object.GetAt(pointer)
+	if err != nil {
+		return "", err
+	}
+	return value.GetString()
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON number.
+func (object *ObjectStruct) GetNumberAt(pointer string) (string, error) {
+	value, err := object.GetAt(pointer)
+	if err != nil {
+		return "", err
+	}
+	return value.GetNumber()
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist,
+// the value isn't a JSON number, or the JSON number cannot be represented as an int64.
+func (object *ObjectStruct) GetInt64At(pointer string) (int64, error) {
+	value, err := object.GetNumberAt(pointer)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := parseSignedInteger(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int64: %w", err)
+	}
+	return parsed, nil
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist,
+// the value isn't a JSON number, or the JSON number is outside the range of a float64.
+func (object *ObjectStruct) GetFloat64At(pointer string) (float64, error) {
+	value, err := object.GetNumberAt(pointer)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := parseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float64: %w", err)
+	}
+	return parsed, nil
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON boolean.
+func (object *ObjectStruct) GetBoolAt(pointer string) (bool, error) {
+	value, err := object.GetAt(pointer)
+	if err != nil {
+		return false, err
+	}
+	return value.GetBool()
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON object.
+func (object *ObjectStruct) GetJSONObjectAt(pointer string) (ObjectStruct, error) {
+	value, err := object.GetAt(pointer)
+	if err != nil {
+		return ObjectStruct{}, err
+	}
+	return value.GetJSONObject()
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON array.
+func (object *ObjectStruct) GetJSONArrayAt(pointer string) (ArrayStruct, error) {
+	value, err := object.GetAt(pointer)
+	if err != nil {
+		return ArrayStruct{}, err
+	}
+	return value.GetJSONArray()
+}
+
+// Sets the value at the location referenced by the JSON Pointer (RFC 6901).
+// Overrides any existing value. The "-" token appends to an array.
+// If createIntermediates is true, missing parent members are created as empty objects.
+// Returns an error if the pointer is invalid or a parent value doesn't exist,
+// in which case the object is left unchanged.
+func (object *ObjectStruct) SetAt(pointer string, value ValueStruct, createIntermediates bool) error {
+	if object.frozen {
+		return ErrFrozen
+	}
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return fmt.Errorf("failed to parse pointer: %w", err)
+	}
+	if len(tokens) == 0 {
+		replaced, err := value.GetJSONObject()
+		if err != nil {
+			return fmt.Errorf("failed to replace root: %w", err)
+		}
+		*object = replaced
+		return nil
+	}
+	updated, err := setJSONPointerValue(NewJSONObjectValue(*object), tokens, value, createIntermediates)
+	if err != nil {
+		return err
+	}
+	*object = updated.object
+	return nil
+}
+
+// Removes the value at the location referenced by the JSON Pointer (RFC 6901).
+// Subsequent array elements are shifted to the left.
+// Returns an error if the pointer is invalid, references the root, or the value doesn't exist,
+// in which case the object is left unchanged.
+func (object *ObjectStruct) DeleteAt(pointer string) error {
+	if object.frozen {
+		return ErrFrozen
+	}
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return fmt.Errorf("failed to parse pointer: %w", err)
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("cannot delete root")
+	}
+	updated, err := deleteJSONPointerValue(NewJSONObjectValue(*object), tokens)
+	if err != nil {
+		return err
+	}
+	*object = updated.object
+	return nil
+}
+
+// Returns the value referenced by the JSON Pointer (RFC 6901).
+// Returns an error if the pointer is invalid or the value doesn't exist.
+func (array *ArrayStruct) GetAt(pointer string) (ValueStruct, error) {
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return ValueStruct{}, fmt.Errorf("failed to parse pointer: %w", err)
+	}
+	return resolveJSONPointer(NewJSONArrayValue(*array), tokens)
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON string.
+func (array *ArrayStruct) GetStringAt(pointer string) (string, error) {
+	value, err := array.GetAt(pointer)
+	if err != nil {
+		return "", err
+	}
+	return value.GetString()
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON number.
+func (array *ArrayStruct) GetNumberAt(pointer string) (string, error) {
+	value, err := array.GetAt(pointer)
+	if err != nil {
+		return "", err
+	}
+	return value.GetNumber()
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist,
+// the value isn't a JSON number, or the JSON number cannot be represented as an int64.
+func (array *ArrayStruct) GetInt64At(pointer string) (int64, error) {
+	value, err := array.GetNumberAt(pointer)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := parseSignedInteger(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int64: %w", err)
+	}
+	return parsed, nil
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist,
+// the value isn't a JSON number, or the JSON number is outside the range of a float64.
+func (array *ArrayStruct) GetFloat64At(pointer string) (float64, error) {
+	value, err := array.GetNumberAt(pointer)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := parseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float64: %w", err)
+	}
+	return parsed, nil
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON boolean.
+func (array *ArrayStruct) GetBoolAt(pointer string) (bool, error) {
+	value, err := array.GetAt(pointer)
+	if err != nil {
+		return false, err
+	}
+	return value.GetBool()
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON object.
+func (array *ArrayStruct) GetJSONObjectAt(pointer string) (ObjectStruct, error) {
+	value, err := array.GetAt(pointer)
+	if err != nil {
+		return ObjectStruct{}, err
+	}
+	return value.GetJSONObject()
+}
+
+// Returns an error if the pointer is invalid, the value doesn't exist, or the value isn't a JSON array.
+func (array *ArrayStruct) GetJSONArrayAt(pointer string) (ArrayStruct, error) {
+	value, err := array.GetAt(pointer)
+	if err != nil {
+		return ArrayStruct{}, err
+	}
+	return value.GetJSONArray()
+}
+
+// Sets the value at the location referenced by the JSON Pointer (RFC 6901).
+// Overrides any existing value. The "-" token appends to an array.
+// If createIntermediates is true, missing parent members are created as empty objects.
+// Returns an error if the pointer is invalid or a parent value doesn't exist,
+// in which case the array is left unchanged.
+func (array *ArrayStruct) SetAt(pointer string, value ValueStruct, createIntermediates bool) error {
+	if array.frozen {
+		return ErrFrozen
+	}
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return fmt.Errorf("failed to parse pointer: %w", err)
+	}
+	if len(tokens) == 0 {
+		replaced, err := value.GetJSONArray()
+		if err != nil {
+			return fmt.Errorf("failed to replace root: %w", err)
+		}
+		*array = replaced
+		return nil
+	}
+	updated, err := setJSONPointerValue(NewJSONArrayValue(*array), tokens, value, createIntermediates)
+	if err != nil {
+		return err
+	}
+	*array = updated.array
+	return nil
+}
+
+// Removes the value at the location referenced by the JSON Pointer (RFC 6901).
+// Subsequent array elements are shifted to the left.
+// Returns an error if the pointer is invalid, references the root, or the value doesn't exist,
+// in which case the array is left unchanged.
+func (array *ArrayStruct) DeleteAt(pointer string) error {
+	if array.frozen {
+		return ErrFrozen
+	}
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return fmt.Errorf("failed to parse pointer: %w", err)
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("cannot delete root")
+	}
+	updated, err := deleteJSONPointerValue(NewJSONArrayValue(*array), tokens)
+	if err != nil {
+		return err
+	}
+	*array = updated.array
+	return nil
+}
@@ -0,0 +1,85 @@
+package json
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetAt(t *testing.T) {
+	object, err := ParseObject(`{"a":{"b":[{"c":"x"}]},"m~n":1,"k/l":2,"":3}`)
+	if err != nil {
+		t.Fatalf("failed to parse object: %s", err)
+	}
+
+	successCases := []successTestCaseStruct{
+		{"/a/b/0/c", `"x"`},
+		{"/m~0n", "1"},
+		{"/k~1l", "2"},
+		{"/", "3"},
+		{"", `{"a":{"b":[{"c":"x"}]},"m~n":1,"k/l":2,"":3}`},
+	}
+	for _, c := range successCases {
+		value, err := object.GetAt(c.input)
+		if err != nil {
+			t.Errorf("error on input: %s: %s", c.input, err)
+			continue
+		}
+		got := value.String(MinimalStringCharacterEscapingBehavior)
+		if got != c.expected {
+			t.Errorf("unexpected output on input %s: %s", c.input, got)
+			continue
+		}
+	}
+
+	failCases := []string{"a", "/a/b/1", "/a/b/01", "/a/b/-", "/a/x", "/m~2n", "/a/b/0/c/d"}
+	for _, c := range failCases {
+		_, err := object.GetAt(c)
+		if err == nil {
+			t.Errorf("expected error on input: %s", c)
+			continue
+		}
+	}
+
+	_, err = object.GetStringAt("/m~0n")
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch: %v", err)
+	}
+}
+
+func TestSetAtAndDeleteAt(t *testing.T) {
+	object, err := ParseObject(`{"a":{"b":[1,2]}}`)
+	if err != nil {
+		t.Fatalf("failed to parse object: %s", err)
+	}
+
+	err = object.SetAt("/a/c", NewStringValue("x"), false)
+	if err != nil {
+		t.Fatalf("failed to set /a/c: %s", err)
+	}
+	err = object.SetAt("/a/b/-", NewNumberValue("3"), false)
+	if err != nil {
+		t.Fatalf("failed to set /a/b/-: %s", err)
+	}
+	err = object.SetAt("/x/y/z", NewBoolValue(true), false)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+	err = object.SetAt("/x/y/z", NewBoolValue(true), true)
+	if err != nil {
+		t.Fatalf("failed to set /x/y/z: %s", err)
+	}
+	err = object.DeleteAt("/a/b/0")
+	if err != nil {
+		t.Fatalf("failed to delete /a/b/0: %s", err)
+	}
+	err = object.DeleteAt("/a/d")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+
+	expected := `{"a":{"b":[2,3],"c":"x"},"x":{"y":{"z":true}}}`
+	got := object.String(MinimalStringCharacterEscapingBehavior)
+	if got != expected {
+		t.Errorf("unexpected output: %s", got)
+	}
+}
@@ -0,0 +1,479 @@
+package json
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// A declarative description of the members of an object.
+// Members without a rule are ignored. Nil rules are reported as violations.
+//
+//	shape := json.Shape{
+//		"email": json.String().Required().MaxLen(254),
+//		"age":   json.Int().Min(0),
+//		"tags":  json.Array(json.String()).MaxLen(10),
+//		"address": json.Object(json.Shape{
+//			"city": json.String().Required(),
+//		}),
+//	}
+//	err := shape.Validate(object)
+type Shape map[string]ShapeRuleInterface
+
+// A rule for a member or element. Use [String], [Int], [Number], [Bool], [Object], and [Array].
+type ShapeRuleInterface interface {
+	// Returns true if the member must exist.
+	isRequired() bool
+	validate(value ValueStruct, pointer string, violations *[]ShapeViolationStruct)
+}
+
+// A value that doesn't match its rule.
+type ShapeViolationStruct struct {
+	// The JSON Pointer of the value (e.g. /addresses/0/city).
+	Pointer string
+	Err     error
+}
+
+func (violation ShapeViolationStruct) Error() string {
+	return fmt.Sprintf("%s: %s", strconv.Quote(violation.Pointer), violation.Err.Error())
+}
+
+// Returned by Shape.Validate() with every violation.
+// Use errors.Is() to check for [ErrNotFound], [ErrTypeMismatch], [ErrUnknownField],
+// [ErrOutOfRange], and [ErrFractionalNumber] in any violation.
+// Violations of Min(), Max(), MinLen(), and MaxLen() match [ErrOutOfRange].
+// Violations of Pattern() and OneOf() don't match any of these errors.
+type ShapeErrorStruct struct {
+	Violations []ShapeViolationStruct
+}
+
+func (err *ShapeErrorStruct) Error() string {
+	messages := make([]string, len(err.Violations))
+	for i, violation := range err.Violations {
+		messages[i] = violation.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (err *ShapeErrorStruct) Unwrap() []error {
+	result := make([]error, len(err.Violations))
+	for i, violation := range err.Violations {
+		result[i] = violation.Err
+	}
+	return result
+}
+
+// Validates the members of the object against the shape.
+// Every violation is reported. Members are visited in the order of their names.
+// Returns a [*ShapeErrorStruct] if the object doesn't match the shape.
+func (shape Shape) Validate(object ObjectStruct) error {
+	violations := []ShapeViolationStruct{}
+	shape.validateObject(&object, "", false, &violations)
+	if len(violations) > 0 {
+		return &ShapeErrorStruct{Violations: violations}
+	}
+	return nil
+}
+
+// If strict, members without a rule are reported with [ErrUnknownField].
+func (shape Shape) validateObject(object *ObjectStruct, pointer string, strict bool, violations *[]ShapeViolationStruct) {
+	keys := make([]string, 0, len(shape))
+	for key := range shape {
+		keys = append(keys, key)
+	}
+	if strict {
+		for _, key := range object.Keys {
+			if _, ok := shape[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		rule, ok := shape[key]
+		memberPointer := pointer + "/" + escapeJSONPointerToken(key)
+		if !ok {
+			*violations = append(*violations, ShapeViolationStruct{Pointer: memberPointer, Err: ErrUnknownField})
+			continue
+		}
+		if rule == nil {
+			addShapeViolation(violations, memberPointer, "nil rule")
+			continue
+		}
+		value, ok := object.get(key)
+		if !ok {
+			if rule.isRequired() {
+				*violations = append(*violations, ShapeViolationStruct{Pointer: memberPointer, Err: ErrNotFound})
+			}
+			continue
+		}
+		rule.validate(value, memberPointer, violations)
+	}
+}
+
+// Adds a violation if the value isn't of the expected kind.
+// Returns false if the value is null and nullable, or doesn't match the kind.
+func checkShapeKind(value ValueStruct, expected Kind, nullable bool, pointer string, violations *[]ShapeViolationStruct) bool {
+	if value.kind == expected {
+		return true
+	}
+	if value.kind != KindNull || !nullable {
+		*violations = append(*violations, ShapeViolationStruct{Pointer: pointer, Err: &TypeMismatchErrorStruct{Expected: expected, Actual: value.kind}})
+	}
+	return false
+}
+
+func addShapeViolation(violations *[]ShapeViolationStruct, pointer string, format string, args ...any) {
+	*violations = append(*violations, ShapeViolationStruct{Pointer: pointer, Err: fmt.Errorf(format, args...)})
+}
+
+// Adds a violation wrapping [ErrOutOfRange].
+func addShapeRangeViolation(violations *[]ShapeViolationStruct, pointer string, format string, args ...any) {
+	err := fmt.Errorf("%w: %s", ErrOutOfRange, fmt.Sprintf(format, args...))
+	*violations = append(*violations, ShapeViolationStruct{Pointer: pointer, Err: err})
+}
+
+// Use [String].
+type StringShapeRuleStruct struct {
+	required bool
+	nullable bool
+	minLen   int
+	maxLen   int
+	pattern  *regexp.Regexp
+	values   []string
+}
+
+// A rule for JSON strings.
+func String() *StringShapeRuleStruct {
+	return &StringShapeRuleStruct{minLen: -1, maxLen: -1}
+}
+
+// The member must exist.
+func (rule *StringShapeRuleStruct) Required() *StringShapeRuleStruct {
+	rule.required = true
+	return rule
+}
+
+// The value may be null.
+func (rule *StringShapeRuleStruct) Nullable() *StringShapeRuleStruct {
+	rule.nullable = true
+	return rule
+}
+
+// The string must have at least n characters (Unicode code points).
+func (rule *StringShapeRuleStruct) MinLen(n int) *StringShapeRuleStruct {
+	rule.minLen = n
+	return rule
+}
+
+// The string must have at most n characters (Unicode code points).
+func (rule *StringShapeRuleStruct) MaxLen(n int) *StringShapeRuleStruct {
+	rule.maxLen = n
+	return rule
+}
+
+// The string must match the regular expression.
+func (rule *StringShapeRuleStruct) Pattern(pattern *regexp.Regexp) *StringShapeRuleStruct {
+	rule.pattern = pattern
+	return rule
+}
+
+// The string must be one of the values.
+func (rule *StringShapeRuleStruct) OneOf(values ...string) *StringShapeRuleStruct {
+	rule.values = values
+	return rule
+}
+
+func (rule *StringShapeRuleStruct) isRequired() bool {
+	return rule.required
+}
+
+func (rule *StringShapeRuleStruct) validate(value ValueStruct, pointer string, violations *[]ShapeViolationStruct) {
+	if !checkShapeKind(value, KindString, rule.nullable, pointer, violations) {
+		return
+	}
+	length := utf8.RuneCountInString(value.s)
+	if rule.minLen >= 0 && length < rule.minLen {
+		addShapeRangeViolation(violations, pointer, "string must have at least %d characters", rule.minLen)
+	}
+	if rule.maxLen >= 0 && length > rule.maxLen {
+		addShapeRangeViolation(violations, pointer, "string must have at most %d characters", rule.maxLen)
+	}
+	if rule.pattern != nil && !rule.pattern.MatchString(value.s) {
+		addShapeViolation(violations, pointer, "string must match pattern %s", strconv.Quote(rule.pattern.String()))
+	}
+	if rule.values != nil && !slices.Contains(rule.values, value.s) {
+		addShapeViolation(violations, pointer, "string must be one of %s", strings.Join(quoteStrings(rule.values), ", "))
+	}
+}
+
+func quoteStrings(values []string) []string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = strconv.Quote(value)
+	}
+	return result
+}
+
+// Use [Int].
+type IntShapeRuleStruct struct {
+	required bool
+	nullable bool
+	min      *int64
+	max      *int64
+}
+
+// A rule for JSON numbers that are integers within the range of int64.
+// Numbers must be integer lexemes like the integer getters (e.g. 1.0 and 1e3 are rejected).
+func Int() *IntShapeRuleStruct {
+	return &IntShapeRuleStruct{}
+}
+
+// The member must exist.
+func (rule *IntShapeRuleStruct) Required() *IntShapeRuleStruct {
+	rule.required = true
+	return rule
+}
+
+// The value may be null.
+func (rule *IntShapeRuleStruct) Nullable() *IntShapeRuleStruct {
+	rule.nullable = true
+	return rule
+}
+
+// The integer must be greater than or equal to n.
+func (rule *IntShapeRuleStruct) Min(n int64) *IntShapeRuleStruct {
+	rule.min = &n
+	return rule
+}
+
+// The integer must be less than or equal to n.
+func (rule *IntShapeRuleStruct) Max(n int64) *IntShapeRuleStruct {
+	rule.max = &n
+	return rule
+}
+
+func (rule *IntShapeRuleStruct) isRequired() bool {
+	return rule.required
+}
+
+func (rule *IntShapeRuleStruct) validate(value ValueStruct, pointer string, violations *[]ShapeViolationStruct) {
+	if !checkShapeKind(value, KindNumber, rule.nullable, pointer, violations) {
+		return
+	}
+	parsed, err := parseSignedInteger(value.s, 64)
+	if err != nil {
+		*violations = append(*violations, ShapeViolationStruct{Pointer: pointer, Err: err})
+		return
+	}
+	if rule.min != nil && parsed < *rule.min {
+		addShapeRangeViolation(violations, pointer, "number must be greater than or equal to %d", *rule.min)
+	}
+	if rule.max != nil && parsed > *rule.max {
+		addShapeRangeViolation(violations, pointer, "number must be less than or equal to %d", *rule.max)
+	}
+}
+
+// Use [Number].
+type NumberShapeRuleStruct struct {
+	required bool
+	nullable bool
+	min      string
+	max      string
+}
+
+// A rule for JSON numbers.
+// Numbers are compared without loss of precision.
+func Number() *NumberShapeRuleStruct {
+	return &NumberShapeRuleStruct{}
+}
+
+// The member must exist.
+func (rule *NumberShapeRuleStruct) Required() *NumberShapeRuleStruct {
+	rule.required = true
+	return rule
+}
+
+// The value may be null.
+func (rule *NumberShapeRuleStruct) Nullable() *NumberShapeRuleStruct {
+	rule.nullable = true
+	return rule
+}
+
+// The number must be greater than or equal to n.
+// Panics if n is NaN or infinite.
+func (rule *NumberShapeRuleStruct) Min(n float64) *NumberShapeRuleStruct {
+	rule.min = mustFormatShapeFloat(n)
+	return rule
+}
+
+// The number must be less than or equal to n.
+// Panics if n is NaN or infinite.
+func (rule *NumberShapeRuleStruct) Max(n float64) *NumberShapeRuleStruct {
+	rule.max = mustFormatShapeFloat(n)
+	return rule
+}
+
+func mustFormatShapeFloat(n float64) string {
+	formatted, err := formatFloat(n, 64)
+	if err != nil {
+		panic(err)
+	}
+	return formatted
+}
+
+func (rule *NumberShapeRuleStruct) isRequired() bool {
+	return rule.required
+}
+
+func (rule *NumberShapeRuleStruct) validate(value ValueStruct, pointer string, violations *[]ShapeViolationStruct) {
+	if !checkShapeKind(value, KindNumber, rule.nullable, pointer, violations) {
+		return
+	}
+	if rule.min != "" && compareNumbers(value.s, rule.min) < 0 {
+		addShapeRangeViolation(violations, pointer, "number must be greater than or equal to %s", rule.min)
+	}
+	if rule.max != "" && compareNumbers(value.s, rule.max) > 0 {
+		addShapeRangeViolation(violations, pointer, "number must be less than or equal to %s", rule.max)
+	}
+}
+
+// Use [Bool].
+type BoolShapeRuleStruct struct {
+	required bool
+	nullable bool
+}
+
+// A rule for JSON booleans.
+func Bool() *BoolShapeRuleStruct {
+	return &BoolShapeRuleStruct{}
+}
+
+// The member must exist.
+func (rule *BoolShapeRuleStruct) Required() *BoolShapeRuleStruct {
+	rule.required = true
+	return rule
+}
+
+// The value may be null.
+func (rule *BoolShapeRuleStruct) Nullable() *BoolShapeRuleStruct {
+	rule.nullable = true
+	return rule
+}
+
+func (rule *BoolShapeRuleStruct) isRequired() bool {
+	return rule.required
+}
+
+func (rule *BoolShapeRuleStruct) validate(value ValueStruct, pointer string, violations *[]ShapeViolationStruct) {
+	checkShapeKind(value, KindBool, rule.nullable, pointer, violations)
+}
+
+// Use [Object].
+type ObjectShapeRuleStruct struct {
+	required bool
+	nullable bool
+	shape    Shape
+	strict   bool
+}
+
+// A rule for JSON objects whose members are validated against the shape.
+func Object(shape Shape) *ObjectShapeRuleStruct {
+	return &ObjectShapeRuleStruct{shape: shape}
+}
+
+// The member must exist.
+func (rule *ObjectShapeRuleStruct) Required() *ObjectShapeRuleStruct {
+	rule.required = true
+	return rule
+}
+
+// The value may be null.
+func (rule *ObjectShapeRuleStruct) Nullable() *ObjectShapeRuleStruct {
+	rule.nullable = true
+	return rule
+}
+
+// Members without a rule in the shape are violations.
+func (rule *ObjectShapeRuleStruct) Strict() *ObjectShapeRuleStruct {
+	rule.strict = true
+	return rule
+}
+
+func (rule *ObjectShapeRuleStruct) isRequired() bool {
+	return rule.required
+}
+
+func (rule *ObjectShapeRuleStruct) validate(value ValueStruct, pointer string, violations *[]ShapeViolationStruct) {
+	if !checkShapeKind(value, KindObject, rule.nullable, pointer, violations) {
+		return
+	}
+	rule.shape.validateObject(&value.object, pointer, rule.strict, violations)
+}
+
+// Use [Array].
+type ArrayShapeRuleStruct struct {
+	required bool
+	nullable bool
+	element  ShapeRuleInterface
+	minLen   int
+	maxLen   int
+}
+
+// A rule for JSON arrays whose elements are validated against the element rule.
+// Required() has no effect on the element rule.
+// A nil element rule is reported as a violation.
+func Array(element ShapeRuleInterface) *ArrayShapeRuleStruct {
+	return &ArrayShapeRuleStruct{element: element, minLen: -1, maxLen: -1}
+}
+
+// The member must exist.
+func (rule *ArrayShapeRuleStruct) Required() *ArrayShapeRuleStruct {
+	rule.required = true
+	return rule
+}
+
+// The value may be null.
+func (rule *ArrayShapeRuleStruct) Nullable() *ArrayShapeRuleStruct {
+	rule.nullable = true
+	return rule
+}
+
+// The array must have at least n elements.
+func (rule *ArrayShapeRuleStruct) MinLen(n int) *ArrayShapeRuleStruct {
+	rule.minLen = n
+	return rule
+}
+
+// The array must have at most n elements.
+func (rule *ArrayShapeRuleStruct) MaxLen(n int) *ArrayShapeRuleStruct {
+	rule.maxLen = n
+	return rule
+}
+
+func (rule *ArrayShapeRuleStruct) isRequired() bool {
+	return rule.required
+}
+
+func (rule *ArrayShapeRuleStruct) validate(value ValueStruct, pointer string, violations *[]ShapeViolationStruct) {
+	if !checkShapeKind(value, KindArray, rule.nullable, pointer, violations) {
+		return
+	}
+	if rule.minLen >= 0 && value.array.Length < rule.minLen {
+		addShapeRangeViolation(violations, pointer, "array must have at least %d elements", rule.minLen)
+	}
+	if rule.maxLen >= 0 && value.array.Length > rule.maxLen {
+		addShapeRangeViolation(violations, pointer, "array must have at most %d elements", rule.maxLen)
+	}
+	if rule.element == nil {
+		addShapeViolation(violations, pointer, "nil element rule")
+		return
+	}
+	for i := range value.array.Length {
+		element, _ := value.array.get(i)
+		rule.element.validate(element, pointer+"/"+strconv.Itoa(i), violations)
+	}
+}
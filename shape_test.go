@@ -0,0 +1,131 @@
+package json
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestShapeValidate(t *testing.T) {
+	shape := Shape{
+		"email":    String().Required().MaxLen(254).Pattern(regexp.MustCompile(`@`)),
+		"age":      Int().Min(0).Max(150),
+		"score":    Number().Min(0).Max(1),
+		"admin":    Bool(),
+		"nickname": String().Nullable(),
+		"role":     String().OneOf("admin", "user"),
+		"tags":     Array(String().MinLen(1)).MaxLen(2),
+		"address": Object(Shape{
+			"city":    String().Required(),
+			"country": String().MinLen(2).MaxLen(2),
+		}).Strict(),
+		"contacts": Array(Object(Shape{
+			"email": String().Required(),
+		})).Required(),
+	}
+
+	validObject, err := ParseObject(`{"email":"user@example.com","age":10,"score":0.5,"admin":true,"nickname":null,"role":"user","tags":["a"],"address":{"city":"Tokyo","country":"JP"},"contacts":[{"email":"a@example.com"}],"other":1}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = shape.Validate(validObject)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	invalidObject, err := ParseObject(`{"age":-1.5,"score":1.0000000000000000001,"admin":null,"role":"owner","tags":["a","",""],"address":{"country":"JPN","zip":"1"},"contacts":[{"email":"a"},{},1]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = shape.Validate(invalidObject)
+	var shapeError *ShapeErrorStruct
+	if !errors.As(err, &shapeError) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []shapeViolationTestCaseStruct{
+		{"/address/city", ErrNotFound},
+		{"/address/country", ErrOutOfRange},
+		{"/address/zip", ErrUnknownField},
+		{"/admin", ErrTypeMismatch},
+		{"/age", ErrFractionalNumber},
+		{"/contacts/1/email", ErrNotFound},
+		{"/contacts/2", ErrTypeMismatch},
+		{"/email", ErrNotFound},
+		{"/role", nil},
+		{"/score", ErrOutOfRange},
+		{"/tags", ErrOutOfRange},
+		{"/tags/1", ErrOutOfRange},
+		{"/tags/2", ErrOutOfRange},
+	}
+	if len(shapeError.Violations) != len(expected) {
+		t.Fatalf("unexpected violations: %s", err)
+	}
+	for i, violation := range shapeError.Violations {
+		if violation.Pointer != expected[i].pointer {
+			t.Errorf("unexpected violation %d: %s", i, violation)
+		}
+		if expected[i].err != nil && !errors.Is(violation.Err, expected[i].err) {
+			t.Errorf("unexpected violation error %d: %s", i, violation)
+		}
+	}
+	if !errors.Is(err, ErrNotFound) || !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected wrapped errors: %s", err)
+	}
+}
+
+func TestShapeValidateRange(t *testing.T) {
+	shape := Shape{
+		"age":  Int().Min(0).Max(150),
+		"role": String().OneOf("admin", "user"),
+	}
+	testCases := []shapeRangeTestCaseStruct{
+		{`{"age":151}`, true},
+		{`{"age":1e9223372036854775807}`, false},
+		{`{"age":1.0}`, false},
+		{`{"age":-1}`, true},
+		{`{"role":"owner"}`, false},
+	}
+	for _, c := range testCases {
+		object, err := ParseObject(c.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = shape.Validate(object)
+		if err == nil {
+			t.Errorf("expected error on input %s", c.s)
+			continue
+		}
+		if errors.Is(err, ErrOutOfRange) != c.outOfRange {
+			t.Errorf("unexpected error on input %s: %s", c.s, err)
+		}
+	}
+}
+
+func TestShapeValidateNilRule(t *testing.T) {
+	shape := Shape{
+		"a": nil,
+		"b": Array(nil),
+	}
+	object, err := ParseObject(`{"b":[1]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = shape.Validate(object)
+	var shapeError *ShapeErrorStruct
+	if !errors.As(err, &shapeError) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(shapeError.Violations) != 2 || shapeError.Violations[0].Pointer != "/a" || shapeError.Violations[1].Pointer != "/b" {
+		t.Errorf("unexpected violations: %s", err)
+	}
+}
+
+type shapeRangeTestCaseStruct struct {
+	s          string
+	outOfRange bool
+}
+
+type shapeViolationTestCaseStruct struct {
+	pointer string
+	err     error
+}
@@ -0,0 +1,161 @@
+package json
+
+import (
+	"fmt"
+)
+
+// Returns an array of JSON strings.
+func ArrayFromStrings(values []string) ArrayStruct {
+	array := NewArray()
+	for _, value := range values {
+		array.AddString(value)
+	}
+	return array
+}
+
+// Returns an array of JSON numbers.
+func ArrayFromInt64s(values []int64) ArrayStruct {
+	array := NewArray()
+	for _, value := range values {
+		array.AddInt64(value)
+	}
+	return array
+}
+
+// Returns an array of JSON numbers.
+// Returns an error if a value is NaN or infinite.
+func ArrayFromFloat64s(values []float64) (ArrayStruct, error) {
+	array := NewArray()
+	for i, value := range values {
+		err := array.AddFloat64(value)
+		if err != nil {
+			return ArrayStruct{}, fmt.Errorf("failed to add value %d: %w", i, err)
+		}
+	}
+	return array, nil
+}
+
+// Returns an array of JSON booleans.
+func ArrayFromBools(values []bool) ArrayStruct {
+	array := NewArray()
+	for _, value := range values {
+		array.AddBool(value)
+	}
+	return array
+}
+
+// Returns an array of JSON objects.
+func ArrayFromObjects(values []ObjectStruct) ArrayStruct {
+	array := NewArray()
+	for _, value := range values {
+		array.AddJSONObject(value)
+	}
+	return array
+}
+
+// Returns an error if an element isn't a JSON string.
+// The error includes the index of the first mismatching element.
+// Named StringSlice() since [ArrayStruct.Strings] returns an iterator.
+func (array *ArrayStruct) StringSlice() ([]string, error) {
+	return arraySlice(array, array.GetString)
+}
+
+// Returns an error if an element isn't a JSON number or the JSON number isn't within the range of an int64.
+// The error includes the index of the first mismatching element.
+func (array *ArrayStruct) Int64s() ([]int64, error) {
+	return arraySlice(array, func(index int) (int64, error) {
+		value, err := array.GetNumber(index)
+		if err != nil {
+			return 0, err
+		}
+		parsed, err := parseSignedInteger(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse element %d as int64: %w", index, err)
+		}
+		return parsed, nil
+	})
+}
+
+// Returns an error if an element isn't a JSON number or the JSON number is outside the range of a float64.
+// The error includes the index of the first mismatching element.
+func (array *ArrayStruct) Float64s() ([]float64, error) {
+	return arraySlice(array, func(index int) (float64, error) {
+		value, err := array.GetNumber(index)
+		if err != nil {
+			return 0, err
+		}
+		parsed, err := parseFloat(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse element %d as float64: %w", index, err)
+		}
+		return parsed, nil
+	})
+}
+
+// Returns an error if an element isn't a JSON boolean.
+// The error includes the index of the first mismatching element.
+// Named BoolSlice() since [ArrayStruct.Bools] returns an iterator.
+func (array *ArrayStruct) BoolSlice() ([]bool, error) {
+	return arraySlice(array, array.GetBool)
+}
+
+// Returns an error if an element isn't a JSON object.
+// The error includes the index of the first mismatching element.
+// Named JSONObjectSlice() since [ArrayStruct.JSONObjects] returns an iterator.
+func (array *ArrayStruct) JSONObjectSlice() ([]ObjectStruct, error) {
+	return arraySlice(array, array.GetJSONObject)
+}
+
+func arraySlice[T any](array *ArrayStruct, get func(index int) (T, error)) ([]T, error) {
+	values := make([]T, array.Length)
+	for i := range array.Length {
+		value, err := get(i)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// Returns an error if the key doesn't exist, the value isn't a JSON array,
+// or an element isn't a JSON string.
+func (object *ObjectStruct) GetStrings(key string) ([]string, error) {
+	return objectSlice(object, key, (*ArrayStruct).StringSlice)
+}
+
+// Returns an error if the key doesn't exist, the value isn't a JSON array,
+// or an element isn't a JSON number within the range of an int64.
+func (object *ObjectStruct) GetInt64s(key string) ([]int64, error) {
+	return objectSlice(object, key, (*ArrayStruct).Int64s)
+}
+
+// Returns an error if the key doesn't exist, the value isn't a JSON array,
+// or an element isn't a JSON number within the range of a float64.
+func (object *ObjectStruct) GetFloat64s(key string) ([]float64, error) {
+	return objectSlice(object, key, (*ArrayStruct).Float64s)
+}
+
+// Returns an error if the key doesn't exist, the value isn't a JSON array,
+// or an element isn't a JSON boolean.
+func (object *ObjectStruct) GetBools(key string) ([]bool, error) {
+	return objectSlice(object, key, (*ArrayStruct).BoolSlice)
+}
+
+// Returns an error if the key doesn't exist, the value isn't a JSON array,
+// or an element isn't a JSON object.
+func (object *ObjectStruct) GetJSONObjects(key string) ([]ObjectStruct, error) {
+	return objectSlice(object, key, (*ArrayStruct).JSONObjectSlice)
+}
+
+func objectSlice[T any](object *ObjectStruct, key string, convert func(array *ArrayStruct) ([]T, error)) ([]T, error) {
+	array, err := object.GetJSONArray(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get array: %w", err)
+	}
+	values, err := convert(&array)
+	if err != nil {
+		return nil, fmt.Errorf("member %s: %w", key, err)
+	}
+	return values, nil
+}
@@ -0,0 +1,54 @@
+package json
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestArraySlices(t *testing.T) {
+	array := ArrayFromStrings([]string{"a", "b"})
+	if got := array.String(MinimalStringCharacterEscapingBehavior); got != `["a","b"]` {
+		t.Errorf("unexpected output: %s", got)
+	}
+	strs, err := array.StringSlice()
+	if err != nil || !slices.Equal(strs, []string{"a", "b"}) {
+		t.Errorf("unexpected strings: %v %v", strs, err)
+	}
+
+	array = ArrayFromInt64s([]int64{1, -2})
+	ints, err := array.Int64s()
+	if err != nil || !slices.Equal(ints, []int64{1, -2}) {
+		t.Errorf("unexpected ints: %v %v", ints, err)
+	}
+
+	array = ArrayFromObjects([]ObjectStruct{NewObject()})
+	objects, err := array.JSONObjectSlice()
+	if err != nil || len(objects) != 1 {
+		t.Errorf("unexpected objects: %v %v", objects, err)
+	}
+
+	empty := NewArray()
+	strs, err = empty.StringSlice()
+	if err != nil || strs == nil || len(strs) != 0 {
+		t.Errorf("unexpected strings: %v %v", strs, err)
+	}
+
+	object, err := ParseObject(`{"a":["x",1,"y"],"b":[1,2.5],"c":"x"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = object.GetStrings("a")
+	if !errors.Is(err, ErrTypeMismatch) || !strings.Contains(err.Error(), "element 1") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	_, err = object.GetInt64s("b")
+	if !errors.Is(err, ErrFractionalNumber) || !strings.Contains(err.Error(), "element 1") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	_, err = object.GetStrings("c")
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package json
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+var (
+	_ sql.Scanner   = (*ObjectStruct)(nil)
+	_ driver.Valuer = ObjectStruct{}
+	_ sql.Scanner   = (*ArrayStruct)(nil)
+	_ driver.Valuer = ArrayStruct{}
+	_ sql.Scanner   = (*NullObjectStruct)(nil)
+	_ driver.Valuer = NullObjectStruct{}
+	_ sql.Scanner   = (*NullArrayStruct)(nil)
+	_ driver.Valuer = NullArrayStruct{}
+)
+
+// Implements database/sql/driver.Valuer.
+// Encodes the object as a string with MinimalStringCharacterEscapingBehavior.
+func (object ObjectStruct) Value() (driver.Value, error) {
+	return object.String(MinimalStringCharacterEscapingBehavior), nil
+}
+
+// Implements database/sql.Scanner.
+// Parses a string or []byte source with ParseObject().
+// Returns an error if the source is NULL. Use NullObjectStruct for nullable columns.
+// Returns ErrFrozen if the object is frozen.
+func (object *ObjectStruct) Scan(src any) error {
+	if object.frozen {
+		return ErrFrozen
+	}
+	s, err := scanSQLText(src)
+	if err != nil {
+		return err
+	}
+	parsed, err := ParseObject(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse object: %w", err)
+	}
+	*object = parsed
+	return nil
+}
+
+// Implements database/sql/driver.Valuer.
+// Encodes the array as a string with MinimalStringCharacterEscapingBehavior.
+func (array ArrayStruct) Value() (driver.Value, error) {
+	return array.String(MinimalStringCharacterEscapingBehavior), nil
+}
+
+// Implements database/sql.Scanner.
+// Parses a string or []byte source with ParseArray().
+// Returns an error if the source is NULL. Use NullArrayStruct for nullable columns.
+// Returns ErrFrozen if the array is frozen.
+func (array *ArrayStruct) Scan(src any) error {
+	if array.frozen {
+		return ErrFrozen
+	}
+	s, err := scanSQLText(src)
+	if err != nil {
+		return err
+	}
+	parsed, err := ParseArray(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse array: %w", err)
+	}
+	*array = parsed
+	return nil
+}
+
+func scanSQLText(src any) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	case nil:
+		return "", errors.New("unexpected NULL")
+	}
+	return "", fmt.Errorf("unsupported source type %T", src)
+}
+
+// An object that may be SQL NULL, like sql.NullString.
+type NullObjectStruct struct {
+	Object ObjectStruct
+	Valid  bool // Valid is true if Object is not NULL.
+}
+
+// Implements database/sql/driver.Valuer.
+// Returns nil if the object is NULL.
+func (nullObject NullObjectStruct) Value() (driver.Value, error) {
+	if !nullObject.Valid {
+		return nil, nil
+	}
+	return nullObject.Object.Value()
+}
+
+// Implements database/sql.Scanner.
+func (nullObject *NullObjectStruct) Scan(src any) error {
+	if src == nil {
+		nullObject.Object, nullObject.Valid = ObjectStruct{}, false
+		return nil
+	}
+	var object ObjectStruct
+	err := object.Scan(src)
+	if err != nil {
+		return err
+	}
+	nullObject.Object, nullObject.Valid = object, true
+	return nil
+}
+
+// An array that may be SQL NULL, like sql.NullString.
+type NullArrayStruct struct {
+	Array ArrayStruct
+	Valid bool // Valid is true if Array is not NULL.
+}
+
+// Implements database/sql/driver.Valuer.
+// Returns nil if the array is NULL.
+func (nullArray NullArrayStruct) Value() (driver.Value, error) {
+	if !nullArray.Valid {
+		return nil, nil
+	}
+	return nullArray.Array.Value()
+}
+
+// Implements database/sql.Scanner.
+func (nullArray *NullArrayStruct) Scan(src any) error {
+	if src == nil {
+		nullArray.Array, nullArray.Valid = ArrayStruct{}, false
+		return nil
+	}
+	var array ArrayStruct
+	err := array.Scan(src)
+	if err != nil {
+		return err
+	}
+	nullArray.Array, nullArray.Valid = array, true
+	return nil
+}
@@ -0,0 +1,151 @@
+package json
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestSQL(t *testing.T) {
+	db := sql.OpenDB(&fakeSQLConnectorStruct{})
+	defer db.Close()
+
+	object, err := ParseObject(`{"a":[1,"b"]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	array, err := ParseArray(`[{"c":null}]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, arg := range []any{object, &array, NullObjectStruct{}, NullObjectStruct{Object: object, Valid: true}} {
+		_, err = db.Exec("insert", arg)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := db.Query("select")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var scannedObject ObjectStruct
+	var scannedArray ArrayStruct
+	var nullObject, validObject NullObjectStruct
+	for _, dest := range []any{&scannedObject, &scannedArray, &nullObject, &validObject} {
+		if !rows.Next() {
+			t.Fatal("missing row")
+		}
+		err = rows.Scan(dest)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := scannedObject.String(MinimalStringCharacterEscapingBehavior); got != `{"a":[1,"b"]}` {
+		t.Errorf("unexpected object: %s", got)
+	}
+	if got := scannedArray.String(MinimalStringCharacterEscapingBehavior); got != `[{"c":null}]` {
+		t.Errorf("unexpected array: %s", got)
+	}
+	if nullObject.Valid {
+		t.Error("expected NULL")
+	}
+	if !validObject.Valid || validObject.Object.String(MinimalStringCharacterEscapingBehavior) != `{"a":[1,"b"]}` {
+		t.Errorf("unexpected nullable object: %v", validObject)
+	}
+
+	if err := scannedObject.Scan(nil); err == nil {
+		t.Error("expected error when scanning NULL")
+	}
+	if err := scannedObject.Scan(1); err == nil {
+		t.Error("expected error when scanning an integer")
+	}
+	if err := scannedArray.Scan(`{}`); err == nil {
+		t.Error("expected error when scanning an object into an array")
+	}
+}
+
+// Stores inserted values and returns them as []byte like most drivers.
+type fakeSQLConnectorStruct struct {
+	values []driver.Value
+}
+
+func (connector *fakeSQLConnectorStruct) Connect(context.Context) (driver.Conn, error) {
+	return &fakeSQLConnStruct{connector}, nil
+}
+
+func (connector *fakeSQLConnectorStruct) Driver() driver.Driver {
+	return nil
+}
+
+type fakeSQLConnStruct struct {
+	connector *fakeSQLConnectorStruct
+}
+
+func (conn *fakeSQLConnStruct) Prepare(query string) (driver.Stmt, error) {
+	return &fakeSQLStmtStruct{conn.connector, query}, nil
+}
+
+func (conn *fakeSQLConnStruct) Close() error {
+	return nil
+}
+
+func (conn *fakeSQLConnStruct) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+type fakeSQLStmtStruct struct {
+	connector *fakeSQLConnectorStruct
+	query     string
+}
+
+func (stmt *fakeSQLStmtStruct) Close() error {
+	return nil
+}
+
+func (stmt *fakeSQLStmtStruct) NumInput() int {
+	if stmt.query == "insert" {
+		return 1
+	}
+	return 0
+}
+
+func (stmt *fakeSQLStmtStruct) Exec(args []driver.Value) (driver.Result, error) {
+	value := args[0]
+	if s, ok := value.(string); ok {
+		value = []byte(s)
+	}
+	stmt.connector.values = append(stmt.connector.values, value)
+	return driver.RowsAffected(1), nil
+}
+
+func (stmt *fakeSQLStmtStruct) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeSQLRowsStruct{values: stmt.connector.values}, nil
+}
+
+type fakeSQLRowsStruct struct {
+	values []driver.Value
+	index  int
+}
+
+func (rows *fakeSQLRowsStruct) Columns() []string {
+	return []string{"document"}
+}
+
+func (rows *fakeSQLRowsStruct) Close() error {
+	return nil
+}
+
+func (rows *fakeSQLRowsStruct) Next(dest []driver.Value) error {
+	if rows.index >= len(rows.values) {
+		return io.EOF
+	}
+	dest[0] = rows.values[rows.index]
+	rows.index++
+	return nil
+}
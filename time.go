@@ -0,0 +1,336 @@
+package json
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// The unit of a Unix timestamp encoded as a JSON number.
+type UnixTimeUnit int
+
+const (
+	// Whole seconds (e.g. 1700000000).
+	// Sub-second precision is dropped when encoding.
+	UnixTimeSeconds UnixTimeUnit = iota
+	// Seconds with an optional fractional part of up to nanosecond precision (e.g. 1700000000.25).
+	UnixTimeFractionalSeconds
+	// Whole milliseconds (e.g. 1700000000250).
+	// Sub-millisecond precision is dropped when encoding.
+	UnixTimeMilliseconds
+)
+
+// The encoding of a duration.
+type DurationFormat int
+
+const (
+	// A JSON string with a Go duration (e.g. "1h30m").
+	DurationFormatString DurationFormat = iota
+	// A JSON number with the number of seconds and an optional fractional part (e.g. 5400 or 0.25).
+	DurationFormatSeconds
+)
+
+func formatTime(value time.Time) string {
+	return value.Format(time.RFC3339Nano)
+}
+
+func parseTime(s string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid RFC 3339 timestamp %s", strconv.Quote(s))
+	}
+	return parsed, nil
+}
+
+func formatUnixTime(value time.Time, unit UnixTimeUnit) string {
+	switch unit {
+	case UnixTimeFractionalSeconds:
+		seconds := new(big.Rat).SetInt64(value.Unix())
+		seconds.Add(seconds, big.NewRat(int64(value.Nanosecond()), int64(time.Second)))
+		// Always has a finite decimal representation.
+		encoded, _ := formatRat(seconds)
+		return encoded
+	case UnixTimeMilliseconds:
+		return strconv.FormatInt(value.UnixMilli(), 10)
+	}
+	return strconv.FormatInt(value.Unix(), 10)
+}
+
+// Returns times in UTC.
+// Fractional seconds beyond nanosecond precision are truncated toward zero.
+func parseUnixTime(lexeme string, unit UnixTimeUnit) (time.Time, error) {
+	switch unit {
+	case UnixTimeFractionalSeconds:
+		seconds, err := parseRat(lexeme)
+		if err != nil {
+			return time.Time{}, err
+		}
+		nanoseconds := new(big.Int).Mul(seconds.Num(), big.NewInt(int64(time.Second)))
+		nanoseconds.Quo(nanoseconds, seconds.Denom())
+		wholeSeconds, remainder := new(big.Int).DivMod(nanoseconds, big.NewInt(int64(time.Second)), new(big.Int))
+		if !wholeSeconds.IsInt64() {
+			return time.Time{}, fmt.Errorf("value %s %w", lexeme, ErrOutOfRange)
+		}
+		return time.Unix(wholeSeconds.Int64(), remainder.Int64()).UTC(), nil
+	case UnixTimeMilliseconds:
+		milliseconds, err := parseSignedInteger(lexeme, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.UnixMilli(milliseconds).UTC(), nil
+	}
+	seconds, err := parseSignedInteger(lexeme, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+func formatDurationSeconds(value time.Duration) string {
+	// Always has a finite decimal representation.
+	encoded, _ := formatRat(big.NewRat(int64(value), int64(time.Second)))
+	return encoded
+}
+
+// Fractional seconds beyond nanosecond precision are truncated.
+func parseDurationSeconds(lexeme string) (time.Duration, error) {
+	seconds, err := parseRat(lexeme)
+	if err != nil {
+		return 0, err
+	}
+	nanoseconds := new(big.Int).Mul(seconds.Num(), big.NewInt(int64(time.Second)))
+	nanoseconds.Quo(nanoseconds, seconds.Denom())
+	if !nanoseconds.IsInt64() {
+		return 0, fmt.Errorf("value %s %w", lexeme, ErrOutOfRange)
+	}
+	return time.Duration(nanoseconds.Int64()), nil
+}
+
+// Set a member with a JSON string value with an RFC 3339 timestamp with nanosecond precision.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetTime(key string, value time.Time) {
+	object.SetString(key, formatTime(value))
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON string,
+// or the JSON string isn't an RFC 3339 timestamp.
+func (object *ObjectStruct) GetTime(key string) (time.Time, error) {
+	value, err := object.GetString(key)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get string: %w", err)
+	}
+	parsed, err := parseTime(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse time: %w", err)
+	}
+	return parsed, nil
+}
+
+// Set a member with a JSON number value with a Unix timestamp.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetUnixTime(key string, value time.Time, unit UnixTimeUnit) {
+	object.SetNumber(key, formatUnixTime(value, unit))
+}
+
+// Returns an error if the key doesn't exist,
+// the value isn't a JSON number,
+// or the JSON number isn't a valid Unix timestamp in the unit.
+// The returned time is in UTC.
+func (object *ObjectStruct) GetUnixTime(key string, unit UnixTimeUnit) (time.Time, error) {
+	value, err := object.GetNumber(key)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnixTime(value, unit)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse Unix time: %w", err)
+	}
+	return parsed, nil
+}
+
+// Set a member with a JSON string or number value depending on the format.
+// Overrides any member with the same name.
+func (object *ObjectStruct) SetDuration(key string, value time.Duration, format DurationFormat) {
+	if format == DurationFormatSeconds {
+		object.SetNumber(key, formatDurationSeconds(value))
+		return
+	}
+	object.SetString(key, value.String())
+}
+
+// Returns an error if the key doesn't exist,
+// the value doesn't match the format,
+// or the value isn't a valid duration.
+func (object *ObjectStruct) GetDuration(key string, format DurationFormat) (time.Duration, error) {
+	if format == DurationFormatSeconds {
+		value, err := object.GetNumber(key)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get number: %w", err)
+		}
+		parsed, err := parseDurationSeconds(value)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse duration: %w", err)
+		}
+		return parsed, nil
+	}
+	value, err := object.GetString(key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get string: %w", err)
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration: %w", err)
+	}
+	return parsed, nil
+}
+
+// Sets a JSON string value with an RFC 3339 timestamp with nanosecond precision at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetTime(index int, value time.Time) {
+	array.SetString(index, formatTime(value))
+}
+
+// Appends a JSON string value with an RFC 3339 timestamp with nanosecond precision at the end of the array.
+func (array *ArrayStruct) AddTime(value time.Time) {
+	array.AddString(formatTime(value))
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON string,
+// or the JSON string isn't an RFC 3339 timestamp.
+func (array *ArrayStruct) GetTime(index int) (time.Time, error) {
+	value, err := array.GetString(index)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get string: %w", err)
+	}
+	parsed, err := parseTime(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse time: %w", err)
+	}
+	return parsed, nil
+}
+
+// Sets a JSON number value with a Unix timestamp at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetUnixTime(index int, value time.Time, unit UnixTimeUnit) {
+	array.SetNumber(index, formatUnixTime(value, unit))
+}
+
+// Appends a JSON number value with a Unix timestamp at the end of the array.
+func (array *ArrayStruct) AddUnixTime(value time.Time, unit UnixTimeUnit) {
+	array.AddNumber(formatUnixTime(value, unit))
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value isn't a JSON number,
+// or the JSON number isn't a valid Unix timestamp in the unit.
+// The returned time is in UTC.
+func (array *ArrayStruct) GetUnixTime(index int, unit UnixTimeUnit) (time.Time, error) {
+	value, err := array.GetNumber(index)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get number: %w", err)
+	}
+	parsed, err := parseUnixTime(value, unit)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse Unix time: %w", err)
+	}
+	return parsed, nil
+}
+
+// Sets a JSON string or number value depending on the format at index.
+// Panics if the index is out of bounds.
+func (array *ArrayStruct) SetDuration(index int, value time.Duration, format DurationFormat) {
+	if format == DurationFormatSeconds {
+		array.SetNumber(index, formatDurationSeconds(value))
+		return
+	}
+	array.SetString(index, value.String())
+}
+
+// Appends a JSON string or number value depending on the format at the end of the array.
+func (array *ArrayStruct) AddDuration(value time.Duration, format DurationFormat) {
+	if format == DurationFormatSeconds {
+		array.AddNumber(formatDurationSeconds(value))
+		return
+	}
+	array.AddString(value.String())
+}
+
+// Returns an error if an item doesn't exist in the index,
+// the value doesn't match the format,
+// or the value isn't a valid duration.
+func (array *ArrayStruct) GetDuration(index int, format DurationFormat) (time.Duration, error) {
+	if format == DurationFormatSeconds {
+		value, err := array.GetNumber(index)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get number: %w", err)
+		}
+		parsed, err := parseDurationSeconds(value)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse duration: %w", err)
+		}
+		return parsed, nil
+	}
+	value, err := array.GetString(index)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get string: %w", err)
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration: %w", err)
+	}
+	return parsed, nil
+}
+
+// Encodes the name to a JSON string and value to a JSON string with an RFC 3339 timestamp
+// with nanosecond precision, and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddTime(name string, value time.Time) {
+	objectBuilder.AddString(name, formatTime(value))
+}
+
+// Encodes the name to a JSON string and value to a JSON number with a Unix timestamp,
+// and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddUnixTime(name string, value time.Time, unit UnixTimeUnit) {
+	objectBuilder.AddJSON(name, formatUnixTime(value, unit))
+}
+
+// Encodes the name to a JSON string and value to a JSON string or number depending on the format,
+// and adds a new object member.
+// Succeeds even if a member with the same name already exists.
+//
+// Control characters not allowed in JSON strings are ignored when encoding values to JSON strings.
+func (objectBuilder *ObjectBuilderStruct) AddDuration(name string, value time.Duration, format DurationFormat) {
+	if format == DurationFormatSeconds {
+		objectBuilder.AddJSON(name, formatDurationSeconds(value))
+		return
+	}
+	objectBuilder.AddString(name, value.String())
+}
+
+// Encodes the value to a JSON string with an RFC 3339 timestamp with nanosecond precision
+// and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddTime(value time.Time) {
+	arrayBuilder.AddString(formatTime(value))
+}
+
+// Encodes the value to a JSON number with a Unix timestamp and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddUnixTime(value time.Time, unit UnixTimeUnit) {
+	arrayBuilder.AddJSON(formatUnixTime(value, unit))
+}
+
+// Encodes the value to a JSON string or number depending on the format and adds it as a new array element.
+func (arrayBuilder *ArrayBuilderStruct) AddDuration(value time.Duration, format DurationFormat) {
+	if format == DurationFormatSeconds {
+		arrayBuilder.AddJSON(formatDurationSeconds(value))
+		return
+	}
+	arrayBuilder.AddString(value.String())
+}
@@ -0,0 +1,109 @@
+package json
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUnixTime(t *testing.T) {
+	value := time.Date(2023, 11, 14, 22, 13, 20, 250000000, time.UTC)
+	successCases := []unixTimeTestCaseStruct{
+		{UnixTimeSeconds, "1700000000", time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)},
+		{UnixTimeFractionalSeconds, "1700000000.25", value},
+		{UnixTimeMilliseconds, "1700000000250", value},
+	}
+	for _, c := range successCases {
+		object := NewObject()
+		object.SetUnixTime("t", value, c.unit)
+		encoded, _ := object.GetNumber("t")
+		if encoded != c.encoded {
+			t.Errorf("unexpected encoding for unit %d: %s", c.unit, encoded)
+		}
+		parsed, err := object.GetUnixTime("t", c.unit)
+		if err != nil {
+			t.Errorf("error on input %s: %s", c.encoded, err)
+			continue
+		}
+		if !parsed.Equal(c.expected) || parsed.Location() != time.UTC {
+			t.Errorf("unexpected time on input %s: %s", c.encoded, parsed)
+		}
+	}
+
+	object, _ := ParseObject(`{"a":-1.5,"b":1.5,"c":1e30,"d":-1.0000000005,"e":1.0000000005}`)
+	parsed, err := object.GetUnixTime("a", UnixTimeFractionalSeconds)
+	if err != nil || !parsed.Equal(time.Unix(-2, 500000000)) {
+		t.Errorf("unexpected result for -1.5: %s %v", parsed, err)
+	}
+	parsed, err = object.GetUnixTime("d", UnixTimeFractionalSeconds)
+	if err != nil || !parsed.Equal(time.Unix(-1, 0)) {
+		t.Errorf("unexpected result for -1.0000000005: %s %v", parsed, err)
+	}
+	parsed, err = object.GetUnixTime("e", UnixTimeFractionalSeconds)
+	if err != nil || !parsed.Equal(time.Unix(1, 0)) {
+		t.Errorf("unexpected result for 1.0000000005: %s %v", parsed, err)
+	}
+	_, err = object.GetUnixTime("b", UnixTimeSeconds)
+	if !errors.Is(err, ErrFractionalNumber) {
+		t.Errorf("expected ErrFractionalNumber, got %v", err)
+	}
+	_, err = object.GetUnixTime("c", UnixTimeFractionalSeconds)
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected ErrOutOfRange, got %v", err)
+	}
+}
+
+func TestTime(t *testing.T) {
+	value := time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.FixedZone("", 9*60*60))
+	array := NewArray()
+	array.AddTime(value)
+	encoded, _ := array.GetString(0)
+	if encoded != "2023-11-14T22:13:20.123456789+09:00" {
+		t.Errorf("unexpected encoding: %s", encoded)
+	}
+	parsed, err := array.GetTime(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Equal(value) {
+		t.Errorf("unexpected time: %s", parsed)
+	}
+
+	array.AddString("2023-11-14 22:13:20")
+	if _, err := array.GetTime(1); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestDuration(t *testing.T) {
+	builder := NewObjectBuilder(MinimalStringCharacterEscapingBehavior)
+	builder.AddDuration("a", 90*time.Minute, DurationFormatString)
+	builder.AddDuration("b", 1500*time.Millisecond, DurationFormatSeconds)
+	builder.AddDuration("c", -time.Nanosecond, DurationFormatSeconds)
+	encoded := builder.Done()
+	if encoded != `{"a":"1h30m0s","b":1.5,"c":-0.000000001}` {
+		t.Errorf("unexpected encoding: %s", encoded)
+	}
+	object, err := ParseObject(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, err := object.GetDuration("a", DurationFormatString); err != nil || d != 90*time.Minute {
+		t.Errorf("unexpected duration: %s %v", d, err)
+	}
+	if d, err := object.GetDuration("b", DurationFormatSeconds); err != nil || d != 1500*time.Millisecond {
+		t.Errorf("unexpected duration: %s %v", d, err)
+	}
+	if d, err := object.GetDuration("c", DurationFormatSeconds); err != nil || d != -time.Nanosecond {
+		t.Errorf("unexpected duration: %s %v", d, err)
+	}
+	if _, err := object.GetDuration("a", DurationFormatSeconds); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch, got %v", err)
+	}
+}
+
+type unixTimeTestCaseStruct struct {
+	unit     UnixTimeUnit
+	encoded  string
+	expected time.Time
+}
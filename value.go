@@ -0,0 +1,192 @@
+package json
+
+// The type of a JSON value.
+type Kind int
+
+const (
+	KindNull Kind = iota
+	KindString
+	KindNumber
+	KindBool
+	KindObject
+	KindArray
+)
+
+func (kind Kind) String() string {
+	switch kind {
+	case KindNull:
+		return "null"
+	case KindString:
+		return "string"
+	case KindNumber:
+		return "number"
+	case KindBool:
+		return "boolean"
+	case KindObject:
+		return "object"
+	case KindArray:
+		return "array"
+	}
+	return "unknown"
+}
+
+// Represents any JSON value.
+// The zero value is a JSON null.
+type ValueStruct struct {
+	kind   Kind
+	s      string // String value or number lexeme.
+	b      bool
+	object ObjectStruct
+	array  ArrayStruct
+}
+
+func NewStringValue(value string) ValueStruct {
+	return ValueStruct{kind: KindString, s: value}
+}
+
+// The value is assumed to be a valid JSON number.
+func NewNumberValue(value string) ValueStruct {
+	return ValueStruct{kind: KindNumber, s: value}
+}
+
+func NewBoolValue(value bool) ValueStruct {
+	return ValueStruct{kind: KindBool, b: value}
+}
+
+func NewNullValue() ValueStruct {
+	return ValueStruct{kind: KindNull}
+}
+
+func NewJSONObjectValue(value ObjectStruct) ValueStruct {
+	return ValueStruct{kind: KindObject, object: value}
+}
+
+func NewJSONArrayValue(value ArrayStruct) ValueStruct {
+	return ValueStruct{kind: KindArray, array: value}
+}
+
+func (value *ValueStruct) Kind() Kind {
+	return value.kind
+}
+
+// Returns an error if the value isn't a JSON string.
+func (value *ValueStruct) GetString() (string, error) {
+	if value.kind != KindString {
+		return "", &TypeMismatchErrorStruct{Expected: KindString, Actual: value.kind}
+	}
+	return value.s, nil
+}
+
+// Returns an error if the value isn't a JSON number.
+func (value *ValueStruct) GetNumber() (string, error) {
+	if value.kind != KindNumber {
+		return "", &TypeMismatchErrorStruct{Expected: KindNumber, Actual: value.kind}
+	}
+	return value.s, nil
+}
+
+// Returns an error if the value isn't a JSON boolean.
+func (value *ValueStruct) GetBool() (bool, error) {
+	if value.kind != KindBool {
+		return false, &TypeMismatchErrorStruct{Expected: KindBool, Actual: value.kind}
+	}
+	return value.b, nil
+}
+
+// Returns an error if the value isn't a JSON object.
+func (value *ValueStruct) GetJSONObject() (ObjectStruct, error) {
+	if value.kind != KindObject {
+		return ObjectStruct{}, &TypeMismatchErrorStruct{Expected: KindObject, Actual: value.kind}
+	}
+	return value.object, nil
+}
+
+// Returns an error if the value isn't a JSON array.
+func (value *ValueStruct) GetJSONArray() (ArrayStruct, error) {
+	if value.kind != KindArray {
+		return ArrayStruct{}, &TypeMismatchErrorStruct{Expected: KindArray, Actual: value.kind}
+	}
+	return value.array, nil
+}
+
+// Returns true if the value is a JSON null.
+func (value *ValueStruct) IsNull() bool {
+	return value.kind == KindNull
+}
+
+// Encodes the value.
+// Objects are encoded with ObjectStruct.String().
+// Arrays are encoded with ArrayStruct.String().
+func (value *ValueStruct) String(stringCharacterEscapingBehavior StringCharacterEscapingBehaviorInterface) string {
+	switch value.kind {
+	case KindString:
+		return encodeString(value.s, stringCharacterEscapingBehavior)
+	case KindNumber:
+		return value.s
+	case KindBool:
+		if value.b {
+			return "true"
+		}
+		return "false"
+	case KindObject:
+		return value.object.String(stringCharacterEscapingBehavior)
+	case KindArray:
+		return value.array.String(stringCharacterEscapingBehavior)
+	}
+	return "null"
+}
+
+// Returns a deep copy of the value.
+func (value *ValueStruct) Clone() ValueStruct {
+	switch value.kind {
+	case KindObject:
+		return NewJSONObjectValue(value.object.Clone())
+	case KindArray:
+		return NewJSONArrayValue(value.array.Clone())
+	}
+	return *value
+}
+
+func (object *ObjectStruct) get(key string) (ValueStruct, bool) {
+	if value, ok := object.strings[key]; ok {
+		return NewStringValue(value), true
+	}
+	if value, ok := object.numbers[key]; ok {
+		return NewNumberValue(value), true
+	}
+	if value, ok := object.bools[key]; ok {
+		return NewBoolValue(value), true
+	}
+	if _, ok := object.nulls[key]; ok {
+		return NewNullValue(), true
+	}
+	if value, ok := object.objects[key]; ok {
+		return NewJSONObjectValue(object.readObject(value)), true
+	}
+	if value, ok := object.arrays[key]; ok {
+		return NewJSONArrayValue(object.readArray(value)), true
+	}
+	return ValueStruct{}, false
+}
+
+func (array *ArrayStruct) get(index int) (ValueStruct, bool) {
+	if value, ok := array.strings[index]; ok {
+		return NewStringValue(value), true
+	}
+	if value, ok := array.numbers[index]; ok {
+		return NewNumberValue(value), true
+	}
+	if value, ok := array.bools[index]; ok {
+		return NewBoolValue(value), true
+	}
+	if _, ok := array.nulls[index]; ok {
+		return NewNullValue(), true
+	}
+	if value, ok := array.objects[index]; ok {
+		return NewJSONObjectValue(array.readObject(value)), true
+	}
+	if value, ok := array.arrays[index]; ok {
+		return NewJSONArrayValue(array.readArray(value)), true
+	}
+	return ValueStruct{}, false
+}